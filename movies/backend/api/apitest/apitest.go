@@ -0,0 +1,78 @@
+// Package apitest serves the API for tests, wired like main does and backed
+// by mocks.
+package apitest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/api"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+const password = "pa55word"
+
+// Env is the API and the services behind it.
+type Env struct {
+	DB       *mocks.MockQueries
+	Users    *service.UserService
+	Notifier *mocks.Notifier[*service.User]
+	// Handler serves the API behind the middleware of srvx, failing the test
+	// when a response doesn't match the spec.
+	Handler http.Handler
+}
+
+func New(t *testing.T) *Env {
+	t.Helper()
+
+	db := mocks.NewMockQueries()
+	notifier := &mocks.Notifier[*service.User]{}
+	us := service.NewUserService(db, notifier)
+
+	h, err := api.NewHandler(service.New(db), us, api.WithResponseValidation(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := srvx.NewServer(srvx.Config{Authenticator: api.NewAuthenticator(us)}, h, slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Env{DB: db, Users: us, Notifier: notifier, Handler: srv.Handler}
+}
+
+// CreateUser registers a user with the password "pa55word", optionally
+// activates them and grants them the permissions, and returns the user and
+// an authentication token for them.
+func (e *Env) CreateUser(t *testing.T, email string, activate bool, permissions ...string) (*service.User, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	user, err := e.Users.RegisterUser(ctx, service.UserInput{Name: email, Email: email, Password: password})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if activate {
+		if _, err := e.Users.ActivateUser(ctx, e.Notifier.Token); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, code := range permissions {
+		if _, err := e.Users.GrantPermission(ctx, user.ID, code); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	token, err := e.Users.CreateAuthenticationToken(ctx, email, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user, token.Plaintext
+}
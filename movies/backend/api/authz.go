@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+// activatedUser marks the operations open to every activated user.
+const activatedUser = ""
+
+// operationAccess restricts reading movies, genres and people to activated
+// users, changing them to users with the movies:write permission and
+// managing permissions to users with the permissions:admin permission. The
+// operations are keyed by the pattern of their route, operations which are
+// not listed, like registration and login, are public.
+var operationAccess = map[string]string{
+	"GET /v1/movies":                        activatedUser,
+	"POST /v1/movies":                       service.PermissionMoviesWrite,
+	"GET /v1/movies/{id}":                   activatedUser,
+	"PATCH /v1/movies/{id}":                 service.PermissionMoviesWrite,
+	"DELETE /v1/movies/{id}":                service.PermissionMoviesWrite,
+	"GET /v1/movies/search":                 activatedUser,
+	"GET /v1/movies/trash":                  service.PermissionMoviesWrite,
+	"GET /v1/movies/export":                 activatedUser,
+	"POST /v1/movies/import":                service.PermissionMoviesWrite,
+	"POST /v1/movies/{id}/restore":          service.PermissionMoviesWrite,
+	"GET /v1/movies/{id}/history":           activatedUser,
+	"GET /v1/movies/{id}/history/{version}": activatedUser,
+	"POST /v1/movies/{id}/revert/{version}": service.PermissionMoviesWrite,
+
+	"GET /v1/movies/{id}/credits":               activatedUser,
+	"POST /v1/movies/{id}/credits":              service.PermissionMoviesWrite,
+	"DELETE /v1/movies/{id}/credits/{creditId}": service.PermissionMoviesWrite,
+
+	"GET /v1/genres":           activatedUser,
+	"POST /v1/genres":          service.PermissionMoviesWrite,
+	"GET /v1/genres/{slug}":    activatedUser,
+	"PATCH /v1/genres/{slug}":  service.PermissionMoviesWrite,
+	"DELETE /v1/genres/{slug}": service.PermissionMoviesWrite,
+
+	"GET /v1/people":         activatedUser,
+	"POST /v1/people":        service.PermissionMoviesWrite,
+	"GET /v1/people/{id}":    activatedUser,
+	"PATCH /v1/people/{id}":  service.PermissionMoviesWrite,
+	"DELETE /v1/people/{id}": service.PermissionMoviesWrite,
+
+	"GET /v1/users/{id}/permissions":           service.PermissionPermissionsAdmin,
+	"POST /v1/users/{id}/permissions":          service.PermissionPermissionsAdmin,
+	"DELETE /v1/users/{id}/permissions/{code}": service.PermissionPermissionsAdmin,
+}
+
+// Authorize checks that the authenticated user may call the operation the
+// request was routed to. It runs before the SpecValidator middleware, so
+// that callers who may not call an operation get a 401 or 403 rather than
+// the validation errors of their input.
+func Authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		permission, restricted := operationAccess[r.Pattern]
+		switch {
+		case !restricted:
+			next.ServeHTTP(w, r)
+		case permission == activatedUser:
+			srvx.RequireActivatedUser(next.ServeHTTP)(w, r)
+		default:
+			srvx.RequirePermission(permission, next.ServeHTTP)(w, r)
+		}
+	})
+}
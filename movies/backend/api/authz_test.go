@@ -0,0 +1,199 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/api/apitest"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestAuthorization(t *testing.T) {
+	env := apitest.New(t)
+	ts := testserver.New(env.Handler)
+	defer ts.Close()
+
+	// The cases run in order against the same data, so the movie is only
+	// deleted after it has been read.
+	env.DB.Reset(mocks.TestMovie1)
+
+	_, inactive := env.CreateUser(t, "inactive@example.com", false, service.PermissionMoviesWrite)
+	_, reader := env.CreateUser(t, "reader@example.com", true)
+	_, writer := env.CreateUser(t, "writer@example.com", true, service.PermissionMoviesWrite)
+	_, admin := env.CreateUser(t, "admin@example.com", true, service.PermissionPermissionsAdmin)
+
+	tcs := []struct {
+		name           string
+		method         string
+		url            string
+		body           string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "anonymous read",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid token",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1",
+			token:          "AAAAAAAAAAAAAAAAAAAAAAAAAA",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "inactive user read",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1",
+			token:          inactive,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "activated user read",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1",
+			token:          reader,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "write without permission",
+			method:         http.MethodDelete,
+			url:            "/v1/movies/1",
+			token:          reader,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "write with permission",
+			method:         http.MethodDelete,
+			url:            "/v1/movies/1",
+			token:          writer,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "history of a deleted movie",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1/history",
+			token:          reader,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "revert without permission",
+			method:         http.MethodPost,
+			url:            "/v1/movies/1/revert/1",
+			token:          reader,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "genres are read by activated users",
+			method:         http.MethodGet,
+			url:            "/v1/genres",
+			token:          reader,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "create genre without permission",
+			method:         http.MethodPost,
+			url:            "/v1/genres",
+			body:           `{"slug": "film-noir", "name": "Film Noir"}`,
+			token:          reader,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "people are read by activated users",
+			method:         http.MethodGet,
+			url:            "/v1/people",
+			token:          reader,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "create person without permission",
+			method:         http.MethodPost,
+			url:            "/v1/people",
+			body:           `{"name": "Ridley Scott"}`,
+			token:          reader,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "grant without permission",
+			method:         http.MethodPost,
+			url:            "/v1/users/2/permissions",
+			body:           fmt.Sprintf(`{"code": %q}`, service.PermissionMoviesWrite),
+			token:          writer,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "grant as admin",
+			method:         http.MethodPost,
+			url:            "/v1/users/2/permissions",
+			body:           fmt.Sprintf(`{"code": %q}`, service.PermissionMoviesWrite),
+			token:          admin,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "revoke as admin",
+			method:         http.MethodDelete,
+			url:            "/v1/users/2/permissions/" + service.PermissionMoviesWrite,
+			token:          admin,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid body of an anonymous write",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			body:           `{"title": 42}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid body of a write without permission",
+			method:         http.MethodPatch,
+			url:            "/v1/people/1",
+			body:           `{"birthYear": "unknown"}`,
+			token:          reader,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid body of a public operation",
+			method:         http.MethodPost,
+			url:            "/v1/users",
+			body:           `{"name": "Bob"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "registration is public",
+			method:         http.MethodPost,
+			url:            "/v1/users",
+			body:           `{"name": "Bob", "email": "bob@example.com", "password": "pa55word"}`,
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, ts.URL+tc.url, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			rs, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rs.Body.Close()
+
+			if rs.StatusCode != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rs.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"iter"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+const (
+	// exportFlushRows is how many movies are buffered before they are
+	// flushed to the client.
+	exportFlushRows = 500
+	// exportWriteTimeout replaces the write timeout of the server for
+	// exports, it is extended after every flush so that only a stalled
+	// client times out.
+	exportWriteTimeout = 30 * time.Second
+)
+
+// movieEncoder writes the movies of an export in one format.
+type movieEncoder interface {
+	encode(m *service.Movie) error
+	// flush writes the movies the encoder buffered itself to the writer.
+	flush() error
+	// close writes what follows the last movie.
+	close() error
+}
+
+type exportFormat struct {
+	mediaType   string
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) (movieEncoder, error)
+}
+
+// exportFormats are offered in this order, the first one is sent to clients
+// without an Accept header.
+var exportFormats = []exportFormat{
+	{"application/json", "application/json", "json", newJSONEncoder},
+	{"text/csv", "text/csv; charset=utf-8", "csv", newCSVEncoder},
+	{"application/x-ndjson", "application/x-ndjson", "ndjson", newNDJSONEncoder},
+}
+
+func (s Server) GetV1MoviesExport(w http.ResponseWriter, r *http.Request, params GetV1MoviesExportParams) {
+	offers := make([]string, len(exportFormats))
+	for i, f := range exportFormats {
+		offers[i] = f.mediaType
+	}
+
+	mediaType, ok := srvx.Negotiate(r, offers...)
+	if !ok {
+		srvx.WriteProblem(w, r, srvx.NewProblem(http.StatusNotAcceptable, srvx.CodeNotAcceptable,
+			"movies can be exported as "+strings.Join(offers, ", ")))
+		return
+	}
+
+	var format exportFormat
+	for _, f := range exportFormats {
+		if f.mediaType == mediaType {
+			format = f
+		}
+	}
+
+	movies, err := s.ms.ExportMovies(r.Context(), params.toService())
+	if err != nil {
+		s.errs.WriteError(w, r, err)
+		return
+	}
+
+	// Read the first movie before sending the headers, so that a failing
+	// query is still reported as a problem.
+	next, stop := iter.Pull2(movies)
+	defer stop()
+
+	first, err, more := next()
+	if err != nil {
+		s.errs.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": "movies-" + time.Now().UTC().Format("20060102") + "." + format.extension,
+	}))
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	// Not every ResponseWriter supports deadlines, such as the recorders of
+	// tests, the export then simply keeps the deadline of the server.
+	_ = rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	bw := bufio.NewWriter(w)
+	exported, err := streamMovies(bw, format, first, more, next, func() error {
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+		_ = rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+		return rc.Flush()
+	})
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		// The status has been sent, all that can be done is to cut the
+		// response short so that the client sees it is incomplete.
+		srvx.LogErr(r, err)
+		panic(http.ErrAbortHandler)
+	}
+
+	srvx.Logger(r.Context()).Info("exported movies", "format", format.extension, "movies", exported)
+}
+
+// streamMovies encodes first, when more is set, and the rest of the movies
+// returned by next, flushing the encoder and calling flush every
+// exportFlushRows movies.
+func streamMovies(
+	w io.Writer, format exportFormat, first *service.Movie, more bool, next func() (*service.Movie, error, bool), flush func() error,
+) (int, error) {
+	enc, err := format.newEncoder(w)
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	for movie := first; more; movie, err, more = next() {
+		if err != nil {
+			return exported, err
+		}
+		if err := enc.encode(movie); err != nil {
+			return exported, err
+		}
+
+		exported++
+		if exported%exportFlushRows == 0 {
+			if err := enc.flush(); err != nil {
+				return exported, err
+			}
+			if err := flush(); err != nil {
+				return exported, err
+			}
+		}
+	}
+
+	return exported, enc.close()
+}
+
+// jsonEncoder writes the movies in the envelope of the listing endpoint.
+type jsonEncoder struct {
+	w     io.Writer
+	first bool
+}
+
+func newJSONEncoder(w io.Writer) (movieEncoder, error) {
+	_, err := io.WriteString(w, `{"movies":[`)
+	return &jsonEncoder{w: w, first: true}, err
+}
+
+func (e *jsonEncoder) encode(m *service.Movie) error {
+	if !e.first {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.first = false
+
+	b, err := json.Marshal(toAPIMovie(m))
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (*jsonEncoder) flush() error {
+	return nil
+}
+
+func (e *jsonEncoder) close() error {
+	_, err := io.WriteString(e.w, "]}\n")
+	return err
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) (movieEncoder, error) {
+	return ndjsonEncoder{enc: json.NewEncoder(w)}, nil
+}
+
+func (e ndjsonEncoder) encode(m *service.Movie) error {
+	return e.enc.Encode(toAPIMovie(m))
+}
+
+func (ndjsonEncoder) flush() error {
+	return nil
+}
+
+func (ndjsonEncoder) close() error {
+	return nil
+}
+
+// csvEncoder writes the columns read by the CSV import, so that an export
+// can be imported again. The csv.Writer has a buffer of its own, which must
+// be flushed before the writer of the export.
+type csvEncoder struct {
+	cw *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) (movieEncoder, error) {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"id", "title", "year", "runtime", "genres", "version"})
+	return csvEncoder{cw: cw}, err
+}
+
+func (e csvEncoder) encode(m *service.Movie) error {
+	return e.cw.Write([]string{
+		strconv.FormatInt(m.ID, 10),
+		m.Title,
+		strconv.Itoa(int(m.Year)),
+		strconv.Itoa(int(m.RuntimeMin)),
+		strings.Join(m.Genres, ","),
+		strconv.Itoa(int(m.Version)),
+	})
+}
+
+func (e csvEncoder) flush() error {
+	e.cw.Flush()
+	return e.cw.Error()
+}
+
+func (e csvEncoder) close() error {
+	return e.flush()
+}
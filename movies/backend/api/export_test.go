@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestExportMovies(t *testing.T) {
+	db := mocks.NewMockQueries()
+	ms := service.New(db)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	// More movies than are flushed at once, so that the export is flushed
+	// through the middlewares.
+	var existing []storage.Movie
+	for i := range 2*exportFlushRows + 1 {
+		existing = append(existing, storage.Movie{
+			ID:         int64(i + 1),
+			Title:      fmt.Sprintf("Movie %d", i+1),
+			Year:       2000 + int32(i%20),
+			RuntimeMin: 90,
+			Genres:     []string{"drama", "comedy"},
+			Version:    1,
+		})
+	}
+
+	export := func(t *testing.T, query, accept string) (*http.Response, string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, ts.URL+"/v1/movies/export"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+
+		rs, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rs.Body.Close()
+
+		body, err := io.ReadAll(rs.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rs, string(body)
+	}
+
+	t.Run("json", func(t *testing.T) {
+		db.Reset(existing...)
+
+		rs, body := export(t, "", "")
+		if rs.StatusCode != http.StatusOK || rs.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("expected a JSON export, got %d %s", rs.StatusCode, rs.Header.Get("Content-Type"))
+		}
+		if got := rs.Header.Get("Content-Disposition"); !strings.HasPrefix(got, "attachment; filename=movies-") ||
+			!strings.HasSuffix(got, ".json") {
+			t.Errorf("unexpected Content-Disposition %q", got)
+		}
+
+		var rsBody struct {
+			Movies []Movie `json:"movies"`
+		}
+		if err := json.Unmarshal([]byte(body), &rsBody); err != nil {
+			t.Fatal(err)
+		}
+		if len(rsBody.Movies) != len(existing) || rsBody.Movies[0].Id != 1 {
+			t.Errorf("expected all %d movies in id order, got %d", len(existing), len(rsBody.Movies))
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		db.Reset(existing...)
+
+		rs, body := export(t, "?year_min=2019&sort=-id", "text/csv")
+		if rs.StatusCode != http.StatusOK || rs.Header.Get("Content-Type") != "text/csv; charset=utf-8" {
+			t.Fatalf("expected a CSV export, got %d %s", rs.StatusCode, rs.Header.Get("Content-Type"))
+		}
+
+		records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := [][]string{
+			{"id", "title", "year", "runtime", "genres", "version"},
+			{"1000", "Movie 1000", "2019", "90", "drama,comedy", "1"},
+		}
+		if len(records) != 1+len(existing)/20 || fmt.Sprint(records[:2]) != fmt.Sprint(want) {
+			t.Errorf("unexpected CSV export, starting with %v", records[:min(2, len(records))])
+		}
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		db.Reset(existing...)
+
+		rs, body := export(t, "?title=movie%2010", "application/x-ndjson, application/json;q=0.5")
+		if rs.StatusCode != http.StatusOK || rs.Header.Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("expected an NDJSON export, got %d %s", rs.StatusCode, rs.Header.Get("Content-Type"))
+		}
+
+		lines := strings.Split(strings.TrimSpace(body), "\n")
+		var m Movie
+		if err := json.Unmarshal([]byte(lines[0]), &m); err != nil {
+			t.Fatal(err)
+		}
+		// Movie 10, Movie 100 to Movie 109, Movie 1000 and Movie 1001.
+		if len(lines) != 13 || m.Title != "Movie 10" {
+			t.Errorf("unexpected NDJSON export %q", body)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		db.Reset()
+
+		rs, body := export(t, "", "application/json")
+		if rs.StatusCode != http.StatusOK || strings.TrimSpace(body) != `{"movies":[]}` {
+			t.Errorf("expected an empty export, got %d %s", rs.StatusCode, body)
+		}
+	})
+
+	tcs := []struct {
+		name           string
+		query          string
+		accept         string
+		injectDBError  error
+		expectedStatus int
+	}{
+		{
+			name:           "not acceptable",
+			accept:         "text/html",
+			expectedStatus: http.StatusNotAcceptable,
+		},
+		{
+			name:           "invalid filter",
+			query:          "?year_min=2010&year_max=2000",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "db error",
+			injectDBError:  errors.New("something went wrong"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			db.Reset(existing...)
+			if tc.injectDBError != nil {
+				db.FailOnNextCall(tc.injectDBError)
+			}
+
+			rs, body := export(t, tc.query, tc.accept)
+			if rs.StatusCode != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, rs.StatusCode, body)
+			}
+			if got := rs.Header.Get("Content-Type"); got != srvx.ProblemContentType {
+				t.Errorf("expected a problem, got %s", got)
+			}
+		})
+	}
+}
+
+func TestStreamMoviesFlushesCSV(t *testing.T) {
+	var buf bytes.Buffer
+	movie := &service.Movie{ID: 1, Title: "Movie", Year: 2000, RuntimeMin: 90, Genres: []string{"drama"}, Version: 1}
+
+	rows := 0
+	next := func() (*service.Movie, error, bool) {
+		rows++
+		return movie, nil, rows < exportFlushRows
+	}
+
+	flushes := 0
+	_, err := streamMovies(&buf, exportFormats[1], movie, true, next, func() error {
+		flushes++
+		// The header and every movie encoded so far reach the writer.
+		if got := strings.Count(buf.String(), "\n"); got != 1+exportFlushRows {
+			t.Errorf("expected %d lines at the flush, got %d", 1+exportFlushRows, got)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flushes != 1 {
+		t.Errorf("expected one flush, got %d", flushes)
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"k8s.io/utils/ptr"
+)
+
+func (s Server) GetV1Genres(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		genres, err := s.ms.ListGenres(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		apiGenres := make([]Genre, len(genres))
+		for i, g := range genres {
+			apiGenres[i] = toAPIGenre(g)
+		}
+
+		return srvx.Envelope{"genres": apiGenres}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1Genres(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput CreateGenreRequest) (srvx.Response[srvx.Envelope], error) {
+		genre, err := s.ms.CreateGenre(ctx, service.GenreInput{
+			Slug:    apiInput.Slug,
+			Name:    apiInput.Name,
+			Aliases: ptr.Deref(apiInput.Aliases, nil),
+		})
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("created genre", "slug", genre.Slug)
+
+		headers := make(http.Header)
+		headers.Set("Location", "/v1/genres/"+url.PathEscape(genre.Slug))
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusCreated,
+			Header: headers,
+			Body:   srvx.Envelope{"genre": toAPIGenre(genre)},
+		}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		genre, err := s.ms.GetGenre(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+
+		return srvx.Envelope{"genre": toAPIGenre(genre)}, nil
+	})(w, r)
+}
+
+func (s Server) PatchV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput UpdateGenreRequest) (srvx.Envelope, error) {
+		genre, err := s.ms.UpdateGenre(ctx, slug, service.PartialGenreUpdate{
+			Name:    apiInput.Name,
+			Aliases: ptr.Deref(apiInput.Aliases, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("updated genre", "slug", genre.Slug)
+
+		return srvx.Envelope{"genre": toAPIGenre(genre)}, nil
+	})(w, r)
+}
+
+func (s Server) DeleteV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		if err := s.ms.DeleteGenre(ctx, slug); err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("deleted genre", "slug", slug)
+
+		return srvx.Envelope{"message": "genre successfully deleted"}, nil
+	})(w, r)
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestGenres(t *testing.T) {
+	db := mocks.NewMockQueries()
+	movieServer := NewServer(service.New(db), nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	// The cases run in order against the same data.
+	db.Reset(mocks.TestMovie1)
+
+	tcs := []struct {
+		name           string
+		method         string
+		url            string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expectedBody   string
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			url:            "/v1/genres",
+			body:           `{"slug": "film-noir", "name": "Film Noir", "aliases": ["Noir"]}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"aliases":["noir"]`,
+		},
+		{
+			name:           "create with an invalid slug",
+			method:         http.MethodPost,
+			url:            "/v1/genres",
+			body:           `{"slug": "Film Noir", "name": "Film Noir"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+		},
+		{
+			name:           "movie with an alias",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			body:           `{"title": "The Third Man", "year": 1949, "runtimeMin": 104, "genres": ["Noir", "Thriller"]}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"genres":["film-noir","thriller"]`,
+		},
+		{
+			name:           "movie with an unknown genre",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			body:           `{"title": "Chinatown", "year": 1974, "runtimeMin": 130, "genres": ["film-nior"]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedBody:   `did you mean \"film-noir\"?`,
+		},
+		{
+			name:           "list movies by an alias",
+			method:         http.MethodGet,
+			url:            "/v1/movies?genres=noir",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"title":"The Third Man"`,
+		},
+		{
+			name:           "list movies by an unknown genre",
+			method:         http.MethodGet,
+			url:            "/v1/movies?genres=film-nior",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedBody:   `did you mean \"film-noir\"?`,
+		},
+		{
+			name:           "rename",
+			method:         http.MethodPatch,
+			url:            "/v1/genres/film-noir",
+			body:           `{"name": "Noir"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"name":"Noir"`,
+		},
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			url:            "/v1/genres/film-noir",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"slug":"film-noir"`,
+		},
+		{
+			name:           "delete a genre in use",
+			method:         http.MethodDelete,
+			url:            "/v1/genres/film-noir",
+			expectedStatus: http.StatusConflict,
+			expectedCode:   srvx.CodeConflict,
+		},
+		{
+			name:           "delete",
+			method:         http.MethodDelete,
+			url:            "/v1/genres/western",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "get a missing genre",
+			method:         http.MethodGet,
+			url:            "/v1/genres/western",
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   srvx.CodeNotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+
+			code, header, rsBody := ts.Do(t, tc.method, tc.url, body)
+			if code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, code, rsBody)
+			}
+			if tc.expectedCode != "" {
+				var problem srvx.Problem
+				if err := json.Unmarshal([]byte(rsBody), &problem); err != nil {
+					t.Fatal(err)
+				}
+				if problem.Code != tc.expectedCode || header.Get("Content-Type") != srvx.ProblemContentType {
+					t.Errorf("expected a %s problem, got %s", tc.expectedCode, rsBody)
+				}
+			}
+
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, []byte(rsBody)); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(compact.String(), tc.expectedBody) {
+				t.Errorf("expected the body to contain %s, got %s", tc.expectedBody, rsBody)
+			}
+		})
+	}
+
+	_, _, list := ts.Get(t, "/v1/genres")
+	var rsBody struct {
+		Genres []Genre `json:"genres"`
+	}
+	if err := json.Unmarshal([]byte(list), &rsBody); err != nil {
+		t.Fatal(err)
+	}
+	if len(rsBody.Genres) != len(mocks.TestGenres) {
+		t.Errorf("expected the catalogue with film-noir and without western, got %d genres", len(rsBody.Genres))
+	}
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+// NewHandler routes requests to the movie and user services the way the API
+// is served: operations are authorized, then requests are validated against
+// the spec and the changes they make are attributed to the authenticated
+// user.
+func NewHandler(ms *service.MovieService, us *service.UserService, opts ...SpecValidatorOption) (http.Handler, error) {
+	v, err := NewSpecValidator(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The generated handlers apply the middlewares in order, each wrapping
+	// the previous one, so the last one runs first.
+	return HandlerWithOptions(NewServer(ms, us), StdHTTPServerOptions{
+		BaseRouter:       http.NewServeMux(),
+		Middlewares:      []MiddlewareFunc{RecordActor, v.Middleware, Authorize},
+		ErrorHandlerFunc: srvx.ErrBadRequest,
+	}), nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestOperationAccess checks that operationAccess restricts exactly the
+// operations which the spec does not declare public, so that a new
+// operation cannot end up open to anonymous callers by accident.
+func TestOperationAccess(t *testing.T) {
+	spec, err := GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operations := make(map[string]bool)
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			pattern := method + " " + path
+			public := op.Security != nil && len(*op.Security) == 0
+			operations[pattern] = true
+
+			if _, restricted := operationAccess[pattern]; restricted == public {
+				t.Errorf("%s: expected restricted %t, got %t", pattern, !public, restricted)
+			}
+		}
+	}
+
+	for pattern := range operationAccess {
+		if !operations[pattern] {
+			t.Errorf("%s: restricted, but not an operation of the spec", pattern)
+		}
+		if method, _, _ := strings.Cut(pattern, " "); method != strings.ToUpper(method) {
+			t.Errorf("%s: expected an upper case method", pattern)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+// RecordActor attributes the changes made by a request to the authenticated
+// user and the trace of the request, in the history of the movies.
+func RecordActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := service.Actor{TraceID: srvx.TraceID(r.Context())}
+		if user, ok := srvx.User[*service.User](r.Context()); ok {
+			actor.UserID = user.ID
+		}
+
+		next.ServeHTTP(w, r.WithContext(service.WithActor(r.Context(), actor)))
+	})
+}
+
+func (s Server) GetV1MoviesIdHistory(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		revisions, err := s.ms.MovieHistory(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		apiRevisions := make([]MovieRevision, len(revisions))
+		for i, revision := range revisions {
+			apiRevisions[i] = toAPIMovieRevision(revision)
+		}
+
+		return srvx.Envelope{"revisions": apiRevisions}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1MoviesIdHistoryVersion(w http.ResponseWriter, r *http.Request, id int64, version int32) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		revision, err := s.ms.GetMovieRevision(ctx, id, version)
+		if err != nil {
+			return nil, err
+		}
+
+		return srvx.Envelope{"revision": toAPIMovieRevision(revision)}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1MoviesIdRevertVersion(w http.ResponseWriter, r *http.Request, id int64, version int32) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Response[srvx.Envelope], error) {
+		movie, err := s.ms.RevertMovie(ctx, id, version)
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("reverted movie", "movie", movie, "to_version", version)
+
+		return movieResponse(movie), nil
+	})(w, r)
+}
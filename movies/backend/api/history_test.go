@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestMovieHistory(t *testing.T) {
+	db := mocks.NewMockQueries()
+	movieServer := NewServer(service.New(db), nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	db.Reset(mocks.TestMovie1)
+
+	code, _, body := ts.Do(t, http.MethodPatch, "/v1/movies/1", strings.NewReader(`{"title": "Django Unchained"}`))
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, code, body)
+	}
+
+	code, header, body := ts.Do(t, http.MethodPost, "/v1/movies/1/revert/1", nil)
+	if code != http.StatusOK || header.Get("ETag") != movieETag(3) {
+		t.Fatalf("expected version 3 to be returned, got %d %s: %s", code, header.Get("ETag"), body)
+	}
+
+	code, _, body = ts.Get(t, "/v1/movies/1/history")
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, code, body)
+	}
+
+	var rsBody struct {
+		Revisions []MovieRevision `json:"revisions"`
+	}
+	if err := json.Unmarshal([]byte(body), &rsBody); err != nil {
+		t.Fatal(err)
+	}
+	if len(rsBody.Revisions) != 3 || rsBody.Revisions[0].Operation != Revert ||
+		rsBody.Revisions[0].Movie.Title != mocks.TestMovie1.Title {
+		t.Fatalf("unexpected history %+v", rsBody.Revisions)
+	}
+
+	tcs := []struct {
+		name           string
+		method         string
+		url            string
+		expectedStatus int
+	}{
+		{
+			name:           "version",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1/history/2",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing version",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1/history/9",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing movie",
+			method:         http.MethodGet,
+			url:            "/v1/movies/9/history",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "revert to a missing version",
+			method:         http.MethodPost,
+			url:            "/v1/movies/1/revert/9",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			code, header, body := ts.Do(t, tc.method, tc.url, nil)
+			if code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, code, body)
+			}
+			if code != http.StatusOK && header.Get("Content-Type") != srvx.ProblemContentType {
+				t.Errorf("expected a problem, got %s", header.Get("Content-Type"))
+			}
+		})
+	}
+}
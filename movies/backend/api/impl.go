@@ -1,114 +1,185 @@
 package api
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/zbsss/greenlight/movies/backend/service"
 	"github.com/zbsss/greenlight/pkg/srvx"
-	"github.com/zbsss/greenlight/pkg/validator"
+	"k8s.io/utils/ptr"
 )
 
 type Server struct {
-	ms *service.MovieService
+	ms   *service.MovieService
+	us   *service.UserService
+	errs *srvx.ErrorMapper
 }
 
-func NewServer(ms *service.MovieService) Server {
-	return Server{ms: ms}
+func NewServer(ms *service.MovieService, us *service.UserService) Server {
+	return Server{ms: ms, us: us, errs: newErrorMapper()}
 }
 
-func (s Server) GetV1Movies(w http.ResponseWriter, r *http.Request) {
-	mvs, err := s.ms.ListMovies(r.Context())
-	if err != nil {
-		srvx.ErrServer(w, r, err)
-		return
-	}
+// newErrorMapper maps the errors of the service layer to the problems
+// returned by the API.
+func newErrorMapper() *srvx.ErrorMapper {
+	return srvx.NewErrorMapper().
+		Map(service.ErrInvalidCursor, http.StatusBadRequest, srvx.CodeBadRequest, "").
+		Map(service.ErrMovieNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested movie could not be found").
+		Map(service.ErrRevisionNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested version of the movie could not be found").
+		Map(service.ErrGenreNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested genre could not be found").
+		Map(service.ErrGenreInUse, http.StatusConflict, srvx.CodeConflict, "the genre can not be deleted while movies have it").
+		Map(service.ErrPersonNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested person could not be found").
+		Map(service.ErrPersonInUse, http.StatusConflict, srvx.CodeConflict, "the person can not be deleted while credited on movies").
+		Map(service.ErrCreditNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested credit could not be found").
+		Map(service.ErrUserNotFound, http.StatusNotFound, srvx.CodeNotFound, "the requested user could not be found").
+		Map(service.ErrEditConflict, http.StatusConflict, srvx.CodeEditConflict,
+			"unable to update the record due to an edit conflict, please try again").
+		Map(service.ErrInvalidCredentials, http.StatusUnauthorized, srvx.CodeInvalidCredentials, "invalid authentication credentials")
+}
 
-	apiMovies := make([]Movie, len(mvs))
-	for i, m := range mvs {
-		apiMovies[i] = toAPIMovie(m)
-	}
+func (s Server) GetV1Movies(w http.ResponseWriter, r *http.Request, params GetV1MoviesParams) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		var (
+			mvs      []*service.Movie
+			metadata service.Metadata
+			err      error
+		)
+
+		if params.isKeyset() {
+			mvs, metadata, err = s.ms.ListMoviesKeyset(ctx, params.toService(), ptr.Deref(params.Cursor, ""))
+		} else {
+			mvs, metadata, err = s.ms.ListMovies(ctx, params.toService())
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	if err := srvx.WriteJSON(w, http.StatusOK, srvx.Envelope{"movies": apiMovies}, nil); err != nil {
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		apiMovies := make([]Movie, len(mvs))
+		for i, m := range mvs {
+			apiMovies[i] = toAPIMovie(m)
+		}
+
+		return srvx.Envelope{"movies": apiMovies, "metadata": toAPIMetadata(metadata)}, nil
+	})(w, r)
 }
 
 func (s Server) PostV1Movies(w http.ResponseWriter, r *http.Request) {
-	var apiInput CreateMovieRequest
-	err := srvx.ReadJSON(w, r, &apiInput)
-	if err != nil {
-		srvx.ErrBadRequest(w, r, err)
-		return
-	}
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput CreateMovieRequest) (srvx.Response[srvx.Envelope], error) {
+		movie, err := s.ms.CreateMovie(ctx, apiInput.toService())
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("created movie", "movie", movie)
 
-	movie, err := s.ms.CreateMovie(r.Context(), apiInput.toService())
-	if err != nil {
-		var validationErr validator.ValidationError
-		if errors.As(err, &validationErr) {
-			srvx.ErrBadRequest(w, r, err)
-			return
+		headers := make(http.Header)
+		headers.Set("Location", fmt.Sprintf("/v1/movies/%d", movie.ID))
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusCreated,
+			Header: headers,
+			Body:   srvx.Envelope{"movie": toAPIMovie(movie)},
+		}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1MoviesId(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Response[srvx.Envelope], error) {
+		movie, err := s.ms.GetMovie(ctx, id)
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
 		}
 
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		return movieResponse(movie), nil
+	})(w, r)
+}
 
-	srvx.Logger(r.Context()).Info("created movie", "movie", movie)
+func (s Server) PatchV1MoviesId(w http.ResponseWriter, r *http.Request, id int64, params PatchV1MoviesIdParams) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput UpdateMovieRequest) (srvx.Response[srvx.Envelope], error) {
+		expectedVersion, err := params.expectedVersion()
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, srvx.BadRequest(err)
+		}
 
-	headers := make(http.Header)
-	headers.Set("Location", fmt.Sprintf("/v1/movies/%d", movie.ID))
+		updates := apiInput.toService()
+		updates.ExpectedVersion = expectedVersion
 
-	if err := srvx.WriteJSON(w, http.StatusCreated, srvx.Envelope{"movie": toAPIMovie(movie)}, headers); err != nil {
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		movie, err := s.ms.UpdateMovie(ctx, id, updates)
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("updated movie", "movie", movie)
+
+		return movieResponse(movie), nil
+	})(w, r)
 }
 
-func (s Server) GetV1MoviesId(w http.ResponseWriter, r *http.Request, id int64) {
-	movie, err := s.ms.GetMovie(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, service.ErrMovieNotFound) {
-			srvx.ErrNotFound(w, r)
-			return
+func (s Server) DeleteV1MoviesId(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		if err := s.ms.DeleteMovie(ctx, id); err != nil {
+			return nil, err
 		}
 
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		srvx.Logger(ctx).Info("deleted movie", "id", id)
 
-	if err := srvx.WriteJSON(w, http.StatusOK, srvx.Envelope{"movie": toAPIMovie(movie)}, nil); err != nil {
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		return srvx.Envelope{"message": "movie moved to the trash"}, nil
+	})(w, r)
 }
 
-func (s Server) PatchV1MoviesId(w http.ResponseWriter, r *http.Request, id int64) {
-	var apiInput UpdateMovieRequest
-	err := srvx.ReadJSON(w, r, &apiInput)
-	if err != nil {
-		srvx.ErrBadRequest(w, r, err)
-		return
-	}
+func (s Server) GetV1MoviesSearch(w http.ResponseWriter, r *http.Request, params GetV1MoviesSearchParams) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		results, err := s.ms.SearchMovies(ctx, params.Q, ptr.Deref(params.Limit, 0))
+		if err != nil {
+			return nil, err
+		}
 
-	movie, err := s.ms.UpdateMovie(r.Context(), id, apiInput.toService())
-	if err != nil {
-		var validationErr validator.ValidationError
-		if errors.As(err, &validationErr) {
-			srvx.ErrBadRequest(w, r, err)
-			return
+		apiResults := make([]SearchResult, len(results))
+		for i, result := range results {
+			apiResults[i] = toAPISearchResult(result)
 		}
 
-		srvx.ErrServer(w, r, err)
-		return
-	}
+		return srvx.Envelope{"results": apiResults}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1MoviesTrash(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		mvs, err := s.ms.ListDeletedMovies(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	srvx.Logger(r.Context()).Info("updated movie", "movie", movie)
+		apiMovies := make([]Movie, len(mvs))
+		for i, m := range mvs {
+			apiMovies[i] = toAPIMovie(m)
+		}
+
+		return srvx.Envelope{"movies": apiMovies}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1MoviesIdRestore(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		movie, err := s.ms.RestoreMovie(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("restored movie", "movie", movie)
+
+		return srvx.Envelope{"movie": toAPIMovie(movie)}, nil
+	})(w, r)
+}
+
+// movieResponse sends the movie with its version in the ETag header.
+func movieResponse(movie *service.Movie) srvx.Response[srvx.Envelope] {
+	headers := make(http.Header)
+	headers.Set("ETag", movieETag(movie.Version))
 
-	if err := srvx.WriteJSON(w, http.StatusOK, srvx.Envelope{"movie": movie}, nil); err != nil {
-		srvx.ErrServer(w, r, err)
-		return
+	return srvx.Response[srvx.Envelope]{
+		Header: headers,
+		Body:   srvx.Envelope{"movie": toAPIMovie(movie)},
 	}
 }
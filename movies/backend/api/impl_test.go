@@ -1,21 +1,23 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/zbsss/greenlight/movies/backend/service"
 	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
 	"github.com/zbsss/greenlight/pkg/srvx/testserver"
 )
 
 func TestGetMovie(t *testing.T) {
 	db := mocks.NewMockQueries()
 	ms := service.New(db)
-	router := http.NewServeMux()
-	movieServer := NewServer(ms)
-	h := HandlerFromMux(movieServer, router)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
 
 	ts := testserver.New(h)
 	defer ts.Close()
@@ -58,8 +60,186 @@ func TestGetMovie(t *testing.T) {
 				t.Fatalf("expected status %d, got %d", tc.expectedStatus, code)
 			}
 
-			if headers.Get("Content-Type") != "application/json" {
-				t.Error("expected Content-Type header to be application/json")
+			expectedContentType := "application/json"
+			if code != http.StatusOK {
+				expectedContentType = srvx.ProblemContentType
+			}
+			if got := headers.Get("Content-Type"); got != expectedContentType {
+				t.Errorf("expected Content-Type header to be %s, got %s", expectedContentType, got)
+			}
+		})
+	}
+}
+
+func TestDeleteMovie(t *testing.T) {
+	db := mocks.NewMockQueries()
+	ms := service.New(db)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	db.Reset(mocks.TestMovie1)
+
+	code, _, _ := ts.Do(t, http.MethodDelete, "/v1/movies/1", nil)
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	code, _, _ = ts.Get(t, "/v1/movies/1")
+	if code != http.StatusNotFound {
+		t.Fatalf("expected deleted movie to return status %d, got %d", http.StatusNotFound, code)
+	}
+
+	code, _, _ = ts.Do(t, http.MethodDelete, "/v1/movies/1", nil)
+	if code != http.StatusNotFound {
+		t.Fatalf("expected second delete to return status %d, got %d", http.StatusNotFound, code)
+	}
+
+	code, _, _ = ts.Do(t, http.MethodPost, "/v1/movies/1/restore", nil)
+	if code != http.StatusOK {
+		t.Fatalf("expected restore to return status %d, got %d", http.StatusOK, code)
+	}
+
+	code, _, _ = ts.Get(t, "/v1/movies/1")
+	if code != http.StatusOK {
+		t.Fatalf("expected restored movie to return status %d, got %d", http.StatusOK, code)
+	}
+}
+
+func TestListMovies(t *testing.T) {
+	db := mocks.NewMockQueries()
+	ms := service.New(db)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	tcs := []struct {
+		name           string
+		query          string
+		expectedStatus int
+	}{
+		{
+			name:           "ok",
+			query:          "?title=django&genres=action&sort=-year&page=1&page_size=10",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid sort",
+			query:          "?sort=created_at",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid page size",
+			query:          "?page_size=1000",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid cursor",
+			query:          "?cursor=garbage",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			db.Reset(mocks.TestMovie1)
+
+			code, _, body := ts.Get(t, "/v1/movies"+tc.query)
+			if code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, code, body)
+			}
+		})
+	}
+}
+
+func TestPatchMovieVersion(t *testing.T) {
+	db := mocks.NewMockQueries()
+	ms := service.New(db)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	tcs := []struct {
+		name           string
+		headers        map[string]string
+		expectedStatus int
+		expectedETag   string
+	}{
+		{
+			name:           "no expected version",
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"2"`,
+		},
+		{
+			name:           "if-match current version",
+			headers:        map[string]string{"If-Match": `"1"`},
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"2"`,
+		},
+		{
+			name:           "if-match any version",
+			headers:        map[string]string{"If-Match": "*"},
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"2"`,
+		},
+		{
+			name:           "if-match stale version",
+			headers:        map[string]string{"If-Match": `"7"`},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "x-expected-version stale",
+			headers:        map[string]string{"X-Expected-Version": "7"},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "malformed if-match",
+			headers:        map[string]string{"If-Match": "1"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "headers disagree",
+			headers:        map[string]string{"If-Match": `"1"`, "X-Expected-Version": "2"},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			db.Reset(mocks.TestMovie1)
+
+			code, headers, _ := ts.Get(t, "/v1/movies/1")
+			if code != http.StatusOK || headers.Get("ETag") != `"1"` {
+				t.Fatalf("expected ETag %q, got %q", `"1"`, headers.Get("ETag"))
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, ts.URL+"/v1/movies/1",
+				strings.NewReader(`{"title": "Django Unchained"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+
+			rs, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rs.Body.Close()
+
+			if rs.StatusCode != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rs.StatusCode)
+			}
+			if got := rs.Header.Get("ETag"); got != tc.expectedETag {
+				t.Fatalf("expected ETag %q, got %q", tc.expectedETag, got)
 			}
 		})
 	}
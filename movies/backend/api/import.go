@@ -0,0 +1,248 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// maxImportBytes is the limit on the size of an import, which is read
+	// as a stream instead of with srvx.ReadJSON.
+	maxImportBytes = 64 << 20
+	// maxImportLineBytes is the limit on the size of one NDJSON row.
+	maxImportLineBytes = 1 << 20
+	// importReadTimeout replaces the read timeout of the server, which is
+	// meant for JSON bodies and too short for large imports.
+	importReadTimeout = 5 * time.Minute
+)
+
+// importParsers read the rows of an import from the body, by media type.
+var importParsers = map[string]func(body io.Reader) (iter.Seq2[service.ImportRow, error], error){
+	"text/csv":             csvRows,
+	"application/x-ndjson": ndjsonRows,
+}
+
+func (s Server) PostV1MoviesImport(w http.ResponseWriter, r *http.Request, params PostV1MoviesImportParams) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	parse, ok := importParsers[mediaType]
+	if !ok {
+		srvx.WriteProblem(w, r, srvx.NewProblem(http.StatusUnsupportedMediaType, srvx.CodeUnsupportedMediaType,
+			"the body must be text/csv or application/x-ndjson"))
+		return
+	}
+
+	// Not every ResponseWriter supports deadlines, such as the recorders of
+	// tests, the import then simply keeps the deadline of the server.
+	_ = http.NewResponseController(w).SetReadDeadline(time.Now().Add(importReadTimeout))
+
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Response[srvx.Envelope], error) {
+		rows, err := parse(http.MaxBytesReader(w, r.Body, maxImportBytes))
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, srvx.BadRequest(err)
+		}
+
+		mode := service.ImportMode(ptr.Deref(params.Mode, PostV1MoviesImportParamsModeAllOrNothing))
+
+		report, err := s.ms.ImportMovies(ctx, badRequestErrors(rows), mode)
+		switch {
+		case errors.Is(err, service.ErrImportRejected):
+			return srvx.Response[srvx.Envelope]{
+				Status: http.StatusUnprocessableEntity,
+				Body:   srvx.Envelope{"report": toAPIImportReport(report)},
+			}, nil
+		case err != nil:
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("imported movies", "mode", mode, "imported", report.Imported, "rejected", report.Rejected)
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusOK,
+			Body:   srvx.Envelope{"report": toAPIImportReport(report)},
+		}, nil
+	})(w, r)
+}
+
+// badRequestErrors marks the errors of reading the body as caused by the
+// request, so that they are reported as a 400.
+func badRequestErrors(rows iter.Seq2[service.ImportRow, error]) iter.Seq2[service.ImportRow, error] {
+	return func(yield func(service.ImportRow, error) bool) {
+		for row, err := range rows {
+			if err != nil {
+				var maxBytesError *http.MaxBytesError
+				if errors.As(err, &maxBytesError) {
+					err = fmt.Errorf("body must not be larger than %d bytes", maxBytesError.Limit)
+				}
+				yield(row, srvx.BadRequest(err))
+				return
+			}
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+}
+
+// csvColumns are the names accepted for each field in the header of a CSV
+// import, the title, year and runtime columns are required.
+var csvColumns = map[string]string{
+	"title":       "title",
+	"year":        "year",
+	"runtime":     "runtimeMin",
+	"runtime_min": "runtimeMin",
+	"runtimemin":  "runtimeMin",
+	"genres":      "genres",
+}
+
+// csvRows reads the header of a CSV import and returns its rows. Rows which
+// are not valid CSV are rejected, the following rows are still read.
+func csvRows(body io.Reader) (iter.Seq2[service.ImportRow, error], error) {
+	cr := csv.NewReader(body)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("body must not be empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\uFEFF")
+		}
+		if field, ok := csvColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[field] = i
+		}
+	}
+	for _, field := range []string{"title", "year", "runtimeMin"} {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("the CSV header must have a %s column", field)
+		}
+	}
+
+	return func(yield func(service.ImportRow, error) bool) {
+		for {
+			record, err := cr.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				row := service.ImportRow{
+					Line:   parseErr.StartLine,
+					Errors: map[string]string{service.ImportRowError: parseErr.Err.Error()},
+				}
+				if !yield(row, nil) {
+					return
+				}
+				continue
+			}
+			if err != nil {
+				yield(service.ImportRow{}, err)
+				return
+			}
+
+			line, _ := cr.FieldPos(0)
+			if !yield(csvRow(line, record, columns), nil) {
+				return
+			}
+		}
+	}, nil
+}
+
+func csvRow(line int, record []string, columns map[string]int) service.ImportRow {
+	row := service.ImportRow{Line: line, Errors: map[string]string{}}
+
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	parseInt := func(name string) int32 {
+		value := field(name)
+		if value == "" {
+			return 0
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			row.Errors[name] = "must be an integer"
+		}
+		return int32(n)
+	}
+
+	row.Input.Title = field("title")
+	row.Input.Year = parseInt("year")
+	row.Input.RuntimeMin = parseInt("runtimeMin")
+
+	if genres := field("genres"); genres != "" {
+		for genre := range strings.SplitSeq(genres, ",") {
+			row.Input.Genres = append(row.Input.Genres, strings.TrimSpace(genre))
+		}
+	}
+
+	return row
+}
+
+// ndjsonRows returns the CreateMovieRequest objects of an NDJSON import,
+// one per line. Blank lines are skipped and lines which are not valid JSON
+// are rejected.
+func ndjsonRows(body io.Reader) (iter.Seq2[service.ImportRow, error], error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineBytes)
+
+	return func(yield func(service.ImportRow, error) bool) {
+		line := 0
+		for scanner.Scan() {
+			line++
+
+			b := bytes.TrimSpace(scanner.Bytes())
+			if len(b) == 0 {
+				continue
+			}
+
+			row := service.ImportRow{Line: line}
+
+			var input CreateMovieRequest
+			if err := json.Unmarshal(b, &input); err != nil {
+				row.Errors = map[string]string{service.ImportRowError: "must be a JSON object with the fields of a movie"}
+			} else {
+				row.Input = input.toService()
+			}
+
+			if !yield(row, nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			if errors.Is(err, bufio.ErrTooLong) {
+				err = fmt.Errorf("line %d is longer than %d bytes", line+1, maxImportLineBytes)
+			}
+			yield(service.ImportRow{}, err)
+		}
+	}, nil
+}
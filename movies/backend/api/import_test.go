@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+	"k8s.io/utils/ptr"
+)
+
+func TestImportMovies(t *testing.T) {
+	db := mocks.NewMockQueries()
+	ms := service.New(db)
+	movieServer := NewServer(ms, nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	const csvBody = "\uFEFFTitle,Year,Runtime,Genres\n" +
+		"Moana,2016,107,\"animation, adventure\"\n" +
+		"Coco,2017,105,animation\n"
+
+	tcs := []struct {
+		name             string
+		query            string
+		contentType      string
+		body             string
+		expectedStatus   int
+		expectedProblem  bool
+		expectedImported int
+		expectedRejected map[int]string
+	}{
+		{
+			name:             "csv",
+			contentType:      "text/csv; charset=utf-8",
+			body:             csvBody,
+			expectedStatus:   http.StatusOK,
+			expectedImported: 2,
+		},
+		{
+			name:        "ndjson",
+			contentType: "application/x-ndjson",
+			body: `{"title": "Moana", "year": 2016, "runtimeMin": 107, "genres": ["animation"]}` + "\n\n" +
+				`{"title": "Coco", "year": 2017, "runtimeMin": 105, "genres": ["animation"], "rating": 5}` + "\n",
+			expectedStatus:   http.StatusOK,
+			expectedImported: 2,
+		},
+		{
+			name:             "csv with rejected rows",
+			contentType:      "text/csv",
+			body:             csvBody + "Up,2009,abc,animation\n\"Cars,2006,117,animation\n",
+			expectedStatus:   http.StatusUnprocessableEntity,
+			expectedRejected: map[int]string{4: "runtimeMin", 5: "row"},
+		},
+		{
+			name:             "best effort",
+			query:            "?mode=best_effort",
+			contentType:      "application/x-ndjson",
+			body:             `{"title": "Moana", "year": 2016, "runtimeMin": 107, "genres": ["animation"]}` + "\n" + `{"title": ` + "\n" + `{"title": "Up"}`,
+			expectedStatus:   http.StatusOK,
+			expectedImported: 1,
+			expectedRejected: map[int]string{2: "row", 3: "year"},
+		},
+		{
+			name:            "missing column",
+			contentType:     "text/csv",
+			body:            "title,year\nMoana,2016\n",
+			expectedStatus:  http.StatusBadRequest,
+			expectedProblem: true,
+		},
+		{
+			name:            "invalid mode",
+			query:           "?mode=sometimes",
+			contentType:     "text/csv",
+			body:            csvBody,
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedProblem: true,
+		},
+		{
+			name:            "unsupported media type",
+			contentType:     "application/json",
+			body:            `[]`,
+			expectedStatus:  http.StatusUnsupportedMediaType,
+			expectedProblem: true,
+		},
+		{
+			name:             "larger than a JSON body",
+			contentType:      "text/csv",
+			body:             "title,year,runtime,genres\n" + strings.Repeat("Moana,2016,107,animation\n", 50_000),
+			expectedStatus:   http.StatusOK,
+			expectedImported: 50_000,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			db.Reset()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, ts.URL+"/v1/movies/import"+tc.query,
+				strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", tc.contentType)
+
+			rs, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rs.Body.Close()
+
+			body, err := io.ReadAll(rs.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if rs.StatusCode != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, rs.StatusCode, body)
+			}
+			if tc.expectedProblem {
+				if got := rs.Header.Get("Content-Type"); got != srvx.ProblemContentType {
+					t.Errorf("expected a problem, got %s", got)
+				}
+				return
+			}
+
+			var rsBody struct {
+				Report ImportReport `json:"report"`
+			}
+			if err := json.Unmarshal(body, &rsBody); err != nil {
+				t.Fatal(err)
+			}
+			report := rsBody.Report
+
+			if report.Imported != tc.expectedImported || report.Rejected != len(tc.expectedRejected) {
+				t.Errorf("expected %d imported and %d rejected, got %d and %d",
+					tc.expectedImported, len(tc.expectedRejected), report.Imported, report.Rejected)
+			}
+
+			for _, row := range report.Rows {
+				field, rejected := tc.expectedRejected[row.Line]
+				if !rejected {
+					if row.Status != Accepted {
+						t.Errorf("expected line %d to be accepted, got %+v", row.Line, row)
+					}
+					continue
+				}
+				if row.Status != Rejected || row.Errors == nil || (*row.Errors)[field] == "" {
+					t.Errorf("expected line %d to be rejected because of %s, got %+v", row.Line, field, row)
+				}
+			}
+
+			_, _, list := ts.Get(t, "/v1/movies")
+			var listBody struct {
+				Metadata Metadata `json:"metadata"`
+			}
+			if err := json.Unmarshal([]byte(list), &listBody); err != nil {
+				t.Fatal(err)
+			}
+			if got := ptr.Deref(listBody.Metadata.TotalRecords, 0); got != int64(tc.expectedImported) {
+				t.Errorf("expected %d movies to be stored, got %d", tc.expectedImported, got)
+			}
+		})
+	}
+}
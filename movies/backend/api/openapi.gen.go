@@ -6,76 +6,1630 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for CreditRole.
+const (
+	Actor           CreditRole = "actor"
+	Cinematographer CreditRole = "cinematographer"
+	Composer        CreditRole = "composer"
+	Director        CreditRole = "director"
+	Editor          CreditRole = "editor"
+	Producer        CreditRole = "producer"
+	Writer          CreditRole = "writer"
+)
+
+// Defines values for GrantPermissionRequestCode.
+const (
+	MoviesWrite      GrantPermissionRequestCode = "movies:write"
+	PermissionsAdmin GrantPermissionRequestCode = "permissions:admin"
+)
+
+// Defines values for ImportReportMode.
+const (
+	ImportReportModeAllOrNothing ImportReportMode = "all_or_nothing"
+	ImportReportModeBestEffort   ImportReportMode = "best_effort"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	Accepted ImportRowStatus = "accepted"
+	Rejected ImportRowStatus = "rejected"
+)
+
+// Defines values for MovieRevisionOperation.
+const (
+	Create   MovieRevisionOperation = "create"
+	Delete   MovieRevisionOperation = "delete"
+	Restore  MovieRevisionOperation = "restore"
+	Revert   MovieRevisionOperation = "revert"
+	Snapshot MovieRevisionOperation = "snapshot"
+	Update   MovieRevisionOperation = "update"
+)
+
+// Defines values for ProblemCode.
+const (
+	ProblemCodeAuthenticationRequired ProblemCode = "authentication_required"
+	ProblemCodeBadRequest             ProblemCode = "bad_request"
+	ProblemCodeConflict               ProblemCode = "conflict"
+	ProblemCodeEditConflict           ProblemCode = "edit_conflict"
+	ProblemCodeForbidden              ProblemCode = "forbidden"
+	ProblemCodeInactiveAccount        ProblemCode = "inactive_account"
+	ProblemCodeInternalError          ProblemCode = "internal_error"
+	ProblemCodeInvalidCredentials     ProblemCode = "invalid_credentials"
+	ProblemCodeInvalidToken           ProblemCode = "invalid_token"
+	ProblemCodeMethodNotAllowed       ProblemCode = "method_not_allowed"
+	ProblemCodeNotAcceptable          ProblemCode = "not_acceptable"
+	ProblemCodeNotFound               ProblemCode = "not_found"
+	ProblemCodeRateLimitExceeded      ProblemCode = "rate_limit_exceeded"
+	ProblemCodeUnsupportedMediaType   ProblemCode = "unsupported_media_type"
+	ProblemCodeValidationFailed       ProblemCode = "validation_failed"
+)
+
+// Defines values for GetV1MoviesParamsSort.
+const (
+	GetV1MoviesParamsSortId           GetV1MoviesParamsSort = "id"
+	GetV1MoviesParamsSortMinusId      GetV1MoviesParamsSort = "-id"
+	GetV1MoviesParamsSortMinusRuntime GetV1MoviesParamsSort = "-runtime"
+	GetV1MoviesParamsSortMinusTitle   GetV1MoviesParamsSort = "-title"
+	GetV1MoviesParamsSortMinusYear    GetV1MoviesParamsSort = "-year"
+	GetV1MoviesParamsSortRuntime      GetV1MoviesParamsSort = "runtime"
+	GetV1MoviesParamsSortTitle        GetV1MoviesParamsSort = "title"
+	GetV1MoviesParamsSortYear         GetV1MoviesParamsSort = "year"
+)
+
+// Defines values for GetV1MoviesParamsPagination.
+const (
+	Keyset GetV1MoviesParamsPagination = "keyset"
+	Offset GetV1MoviesParamsPagination = "offset"
+)
+
+// Defines values for GetV1MoviesExportParamsSort.
+const (
+	GetV1MoviesExportParamsSortId           GetV1MoviesExportParamsSort = "id"
+	GetV1MoviesExportParamsSortMinusId      GetV1MoviesExportParamsSort = "-id"
+	GetV1MoviesExportParamsSortMinusRuntime GetV1MoviesExportParamsSort = "-runtime"
+	GetV1MoviesExportParamsSortMinusTitle   GetV1MoviesExportParamsSort = "-title"
+	GetV1MoviesExportParamsSortMinusYear    GetV1MoviesExportParamsSort = "-year"
+	GetV1MoviesExportParamsSortRuntime      GetV1MoviesExportParamsSort = "runtime"
+	GetV1MoviesExportParamsSortTitle        GetV1MoviesExportParamsSort = "title"
+	GetV1MoviesExportParamsSortYear         GetV1MoviesExportParamsSort = "year"
+)
+
+// Defines values for PostV1MoviesImportParamsMode.
+const (
+	PostV1MoviesImportParamsModeAllOrNothing PostV1MoviesImportParamsMode = "all_or_nothing"
+	PostV1MoviesImportParamsModeBestEffort   PostV1MoviesImportParamsMode = "best_effort"
+)
+
+// ActivateUserRequest defines model for ActivateUserRequest.
+type ActivateUserRequest struct {
+	Token string `json:"token"`
+}
+
+// AuthenticationToken defines model for AuthenticationToken.
+type AuthenticationToken struct {
+	Expiry time.Time `json:"expiry"`
+
+	// Token Send as "Authorization: Bearer <token>"
+	Token string `json:"token"`
+}
+
+// CreateAuthenticationTokenRequest defines model for CreateAuthenticationTokenRequest.
+type CreateAuthenticationTokenRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// CreateCreditRequest defines model for CreateCreditRequest.
+type CreateCreditRequest struct {
+	// BillingOrder Position among the credits of the movie, after the existing credits when omitted
+	BillingOrder *int32 `json:"billingOrder,omitempty"`
+
+	// Character Name of the character, only for actors
+	Character *string    `json:"character,omitempty"`
+	PersonId  int64      `json:"personId"`
+	Role      CreditRole `json:"role"`
+}
+
+// CreateGenreRequest defines model for CreateGenreRequest.
+type CreateGenreRequest struct {
+	// Aliases Other spellings of the genre, stored as slugs
+	Aliases *[]string `json:"aliases,omitempty"`
+	Name    string    `json:"name"`
+	Slug    string    `json:"slug"`
+}
+
 // CreateMovieRequest defines model for CreateMovieRequest.
 type CreateMovieRequest struct {
+	// Genres Slugs or aliases of genres in the catalogue, stored as slugs
 	Genres     []string `json:"genres"`
 	RuntimeMin int32    `json:"runtimeMin"`
 	Title      string   `json:"title"`
-	Year       int32    `json:"year"`
+
+	// Year Must not be in the future
+	Year int32 `json:"year"`
+}
+
+// CreatePersonRequest defines model for CreatePersonRequest.
+type CreatePersonRequest struct {
+	// BirthYear Must not be in the future
+	BirthYear *int32 `json:"birthYear,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Credit defines model for Credit.
+type Credit struct {
+	BillingOrder int32 `json:"billingOrder"`
+
+	// Character Name of the character, only set for actors
+	Character  *string    `json:"character,omitempty"`
+	Id         int64      `json:"id"`
+	PersonId   int64      `json:"personId"`
+	PersonName string     `json:"personName"`
+	Role       CreditRole `json:"role"`
+}
+
+// CreditRole defines model for CreditRole.
+type CreditRole string
+
+// Genre defines model for Genre.
+type Genre struct {
+	// Aliases Other spellings which are stored as the slug when movies are written
+	Aliases []string `json:"aliases"`
+
+	// Name Display name
+	Name string `json:"name"`
+
+	// Slug Identifies the genre in the genres of movies
+	Slug string `json:"slug"`
+}
+
+// GrantPermissionRequest defines model for GrantPermissionRequest.
+type GrantPermissionRequest struct {
+	Code GrantPermissionRequestCode `json:"code"`
+}
+
+// GrantPermissionRequestCode defines model for GrantPermissionRequest.Code.
+type GrantPermissionRequestCode string
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Accepted Number of valid rows
+	Accepted int `json:"accepted"`
+
+	// Imported Number of movies inserted
+	Imported int              `json:"imported"`
+	Mode     ImportReportMode `json:"mode"`
+
+	// Rejected Number of invalid rows
+	Rejected int         `json:"rejected"`
+	Rows     []ImportRow `json:"rows"`
+}
+
+// ImportReportMode defines model for ImportReport.Mode.
+type ImportReportMode string
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	// Errors Invalid fields mapped to what is wrong with them, "row" when the row could not be parsed at all
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Line Line of the body the row starts on
+	Line   int             `json:"line"`
+	Status ImportRowStatus `json:"status"`
+}
+
+// ImportRowStatus defines model for ImportRow.Status.
+type ImportRowStatus string
+
+// Metadata Pagination details, empty when there are no results
+type Metadata struct {
+	CurrentPage *int32 `json:"current_page,omitempty"`
+	FirstPage   *int32 `json:"first_page,omitempty"`
+	LastPage    *int32 `json:"last_page,omitempty"`
+
+	// NextCursor Cursor for the next keyset page, omitted on the last page
+	NextCursor   *string `json:"next_cursor,omitempty"`
+	PageSize     *int32  `json:"page_size,omitempty"`
+	TotalRecords *int64  `json:"total_records,omitempty"`
+}
+
+// Movie defines model for Movie.
+type Movie struct {
+	// DeletedAt Time the movie was moved to the trash, only set for deleted movies
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Genres    []string   `json:"genres"`
+	Id        int64      `json:"id"`
+
+	// Runtime Runtime in minutes, formatted as "X min"
+	Runtime string `json:"runtime"`
+	Title   string `json:"title"`
+	Version int32  `json:"version"`
+
+	// Year Must not be in the future
+	Year int32 `json:"year"`
+}
+
+// MovieRevision defines model for MovieRevision.
+type MovieRevision struct {
+	// ChangedFields Fields of the movie changed by the revision
+	ChangedFields []string  `json:"changedFields"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Movie         Movie     `json:"movie"`
+
+	// Operation The change which produced the revision, snapshot is the state of movies which existed before the history was recorded
+	Operation MovieRevisionOperation `json:"operation"`
+
+	// TraceId Trace ID of the request which made the change
+	TraceId string `json:"traceId"`
+
+	// UserId User who made the change, not set for changes made outside of the API
+	UserId  *int64 `json:"userId,omitempty"`
+	Version int32  `json:"version"`
+}
+
+// MovieRevisionOperation The change which produced the revision, snapshot is the state of movies which existed before the history was recorded
+type MovieRevisionOperation string
+
+// PermissionsResponse defines model for PermissionsResponse.
+type PermissionsResponse struct {
+	Permissions []string `json:"permissions"`
+}
+
+// Person defines model for Person.
+type Person struct {
+	// BirthYear Omitted when the birth year is unknown
+	BirthYear *int32 `json:"birthYear,omitempty"`
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+}
+
+// Problem RFC 9457 problem details
+type Problem struct {
+	// Code Stable machine-readable error code
+	Code ProblemCode `json:"code"`
+
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Invalid fields mapped to what is wrong with them, only set for validation_failed
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Instance Trace ID of the request, for finding it in the logs
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI identifying the problem type, derived from the code
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable machine-readable error code
+type ProblemCode string
+
+// RegisterUserRequest defines model for RegisterUserRequest.
+type RegisterUserRequest struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Movie Movie `json:"movie"`
+
+	// Score Relevance of the movie to the query, higher is better
+	Score float32 `json:"score"`
+
+	// Snippet HTML fragment of the title with the matching words wrapped in <mark> tags. The title is HTML-escaped, so the fragment can be rendered as HTML.
+	Snippet string `json:"snippet"`
+}
+
+// UpdateGenreRequest defines model for UpdateGenreRequest.
+type UpdateGenreRequest struct {
+	// Aliases Replaces all the aliases of the genre
+	Aliases *[]string `json:"aliases,omitempty"`
+	Name    *string   `json:"name,omitempty"`
+}
+
+// UpdateMovieRequest defines model for UpdateMovieRequest.
+type UpdateMovieRequest struct {
+	// Genres Slugs or aliases of genres in the catalogue, stored as slugs
+	Genres     *[]string `json:"genres,omitempty"`
+	RuntimeMin *int32    `json:"runtimeMin,omitempty"`
+	Title      *string   `json:"title,omitempty"`
+
+	// Year Must not be in the future
+	Year *int32 `json:"year,omitempty"`
+}
+
+// UpdatePersonRequest defines model for UpdatePersonRequest.
+type UpdatePersonRequest struct {
+	// BirthYear Must not be in the future
+	BirthYear *int32 `json:"birthYear,omitempty"`
+
+	// ClearBirthYear Removes the birth year of the person, can not be combined with birthYear
+	ClearBirthYear *bool   `json:"clearBirthYear,omitempty"`
+	Name           *string `json:"name,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Activated bool      `json:"activated"`
+	CreatedAt time.Time `json:"createdAt"`
+	Email     string    `json:"email"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// Error RFC 9457 problem details
+type Error = Problem
+
+// Forbidden RFC 9457 problem details
+type Forbidden = Problem
+
+// Unauthorized RFC 9457 problem details
+type Unauthorized = Problem
+
+// UnprocessableEntity RFC 9457 problem details
+type UnprocessableEntity = Problem
+
+// GetV1MoviesParams defines parameters for GetV1Movies.
+type GetV1MoviesParams struct {
+	// Title Only return movies whose title contains this value (case-insensitive)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+
+	// Genres Only return movies which have all of these genres, given by slug or alias
+	Genres  *[]string `form:"genres,omitempty" json:"genres,omitempty"`
+	YearMin *int32    `form:"year_min,omitempty" json:"year_min,omitempty"`
+	YearMax *int32    `form:"year_max,omitempty" json:"year_max,omitempty"`
+
+	// RuntimeMin Minimum runtime in minutes
+	RuntimeMin *int32 `form:"runtime_min,omitempty" json:"runtime_min,omitempty"`
+
+	// RuntimeMax Maximum runtime in minutes
+	RuntimeMax *int32 `form:"runtime_max,omitempty" json:"runtime_max,omitempty"`
+
+	// PersonId Only return movies on which this person is credited
+	PersonId *int64 `form:"person_id,omitempty" json:"person_id,omitempty"`
+
+	// Sort Sort order, prefix with "-" for descending
+	Sort     *GetV1MoviesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page     *int32                 `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int32                 `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Pagination Pagination mode. Keyset pagination ignores page numbers and instead
+	// returns a next_cursor which resumes the listing after the last movie.
+	Pagination *GetV1MoviesParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// Cursor Opaque next_cursor from a previous keyset page, implies keyset pagination
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetV1MoviesParamsSort defines parameters for GetV1Movies.
+type GetV1MoviesParamsSort string
+
+// GetV1MoviesParamsPagination defines parameters for GetV1Movies.
+type GetV1MoviesParamsPagination string
+
+// GetV1MoviesExportParams defines parameters for GetV1MoviesExport.
+type GetV1MoviesExportParams struct {
+	// Title Only export movies whose title contains this value (case-insensitive)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+
+	// Genres Only export movies which have all of these genres, given by slug or alias
+	Genres  *[]string `form:"genres,omitempty" json:"genres,omitempty"`
+	YearMin *int32    `form:"year_min,omitempty" json:"year_min,omitempty"`
+	YearMax *int32    `form:"year_max,omitempty" json:"year_max,omitempty"`
+
+	// RuntimeMin Minimum runtime in minutes
+	RuntimeMin *int32 `form:"runtime_min,omitempty" json:"runtime_min,omitempty"`
+
+	// RuntimeMax Maximum runtime in minutes
+	RuntimeMax *int32 `form:"runtime_max,omitempty" json:"runtime_max,omitempty"`
+
+	// PersonId Only export movies on which this person is credited
+	PersonId *int64 `form:"person_id,omitempty" json:"person_id,omitempty"`
+
+	// Sort Sort order, prefix with "-" for descending
+	Sort *GetV1MoviesExportParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetV1MoviesExportParamsSort defines parameters for GetV1MoviesExport.
+type GetV1MoviesExportParamsSort string
+
+// PostV1MoviesImportParams defines parameters for PostV1MoviesImport.
+type PostV1MoviesImportParams struct {
+	Mode *PostV1MoviesImportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// PostV1MoviesImportParamsMode defines parameters for PostV1MoviesImport.
+type PostV1MoviesImportParamsMode string
+
+// GetV1MoviesSearchParams defines parameters for GetV1MoviesSearch.
+type GetV1MoviesSearchParams struct {
+	Q     string `form:"q" json:"q"`
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchV1MoviesIdParams defines parameters for PatchV1MoviesId.
+type PatchV1MoviesIdParams struct {
+	// IfMatch ETag of the version the update is based on
+	IfMatch *string `json:"If-Match,omitempty"`
+
+	// XExpectedVersion Version the update is based on
+	XExpectedVersion *int32 `json:"X-Expected-Version,omitempty"`
+}
+
+// GetV1PeopleParams defines parameters for GetV1People.
+type GetV1PeopleParams struct {
+	// Name Only return people whose name contains this value (case-insensitive)
+	Name     *string `form:"name,omitempty" json:"name,omitempty"`
+	Page     *int32  `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// PostV1GenresJSONRequestBody defines body for PostV1Genres for application/json ContentType.
+type PostV1GenresJSONRequestBody = CreateGenreRequest
+
+// PatchV1GenresSlugJSONRequestBody defines body for PatchV1GenresSlug for application/json ContentType.
+type PatchV1GenresSlugJSONRequestBody = UpdateGenreRequest
+
+// PostV1MoviesJSONRequestBody defines body for PostV1Movies for application/json ContentType.
+type PostV1MoviesJSONRequestBody = CreateMovieRequest
+
+// PatchV1MoviesIdJSONRequestBody defines body for PatchV1MoviesId for application/json ContentType.
+type PatchV1MoviesIdJSONRequestBody = UpdateMovieRequest
+
+// PostV1MoviesIdCreditsJSONRequestBody defines body for PostV1MoviesIdCredits for application/json ContentType.
+type PostV1MoviesIdCreditsJSONRequestBody = CreateCreditRequest
+
+// PostV1PeopleJSONRequestBody defines body for PostV1People for application/json ContentType.
+type PostV1PeopleJSONRequestBody = CreatePersonRequest
+
+// PatchV1PeopleIdJSONRequestBody defines body for PatchV1PeopleId for application/json ContentType.
+type PatchV1PeopleIdJSONRequestBody = UpdatePersonRequest
+
+// PostV1TokensAuthenticationJSONRequestBody defines body for PostV1TokensAuthentication for application/json ContentType.
+type PostV1TokensAuthenticationJSONRequestBody = CreateAuthenticationTokenRequest
+
+// PostV1UsersJSONRequestBody defines body for PostV1Users for application/json ContentType.
+type PostV1UsersJSONRequestBody = RegisterUserRequest
+
+// PutV1UsersActivatedJSONRequestBody defines body for PutV1UsersActivated for application/json ContentType.
+type PutV1UsersActivatedJSONRequestBody = ActivateUserRequest
+
+// PostV1UsersIdPermissionsJSONRequestBody defines body for PostV1UsersIdPermissions for application/json ContentType.
+type PostV1UsersIdPermissionsJSONRequestBody = GrantPermissionRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the genre catalogue
+	// (GET /v1/genres)
+	GetV1Genres(w http.ResponseWriter, r *http.Request)
+	// Add a genre to the catalogue
+	// (POST /v1/genres)
+	PostV1Genres(w http.ResponseWriter, r *http.Request)
+	// Delete a genre and its aliases
+	// (DELETE /v1/genres/{slug})
+	DeleteV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string)
+	// Get a genre by slug
+	// (GET /v1/genres/{slug})
+	GetV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string)
+	// Rename a genre or replace its aliases
+	// (PATCH /v1/genres/{slug})
+	PatchV1GenresSlug(w http.ResponseWriter, r *http.Request, slug string)
+	// List movies
+	// (GET /v1/movies)
+	GetV1Movies(w http.ResponseWriter, r *http.Request, params GetV1MoviesParams)
+	// Create a new movie
+	// (POST /v1/movies)
+	PostV1Movies(w http.ResponseWriter, r *http.Request)
+	// Export movies as CSV, NDJSON or JSON
+	// (GET /v1/movies/export)
+	GetV1MoviesExport(w http.ResponseWriter, r *http.Request, params GetV1MoviesExportParams)
+	// Import movies from CSV or NDJSON
+	// (POST /v1/movies/import)
+	PostV1MoviesImport(w http.ResponseWriter, r *http.Request, params PostV1MoviesImportParams)
+	// Search movies by title
+	// (GET /v1/movies/search)
+	GetV1MoviesSearch(w http.ResponseWriter, r *http.Request, params GetV1MoviesSearchParams)
+	// List movies in the trash
+	// (GET /v1/movies/trash)
+	GetV1MoviesTrash(w http.ResponseWriter, r *http.Request)
+	// Move a movie to the trash
+	// (DELETE /v1/movies/{id})
+	DeleteV1MoviesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Get a movie by ID
+	// (GET /v1/movies/{id})
+	GetV1MoviesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Update a movie
+	// (PATCH /v1/movies/{id})
+	PatchV1MoviesId(w http.ResponseWriter, r *http.Request, id int64, params PatchV1MoviesIdParams)
+	// List the cast and crew of a movie
+	// (GET /v1/movies/{id}/credits)
+	GetV1MoviesIdCredits(w http.ResponseWriter, r *http.Request, id int64)
+	// Credit a person on a movie
+	// (POST /v1/movies/{id}/credits)
+	PostV1MoviesIdCredits(w http.ResponseWriter, r *http.Request, id int64)
+	// Remove a credit from a movie
+	// (DELETE /v1/movies/{id}/credits/{creditId})
+	DeleteV1MoviesIdCreditsCreditId(w http.ResponseWriter, r *http.Request, id int64, creditId int64)
+	// List the revisions of a movie
+	// (GET /v1/movies/{id}/history)
+	GetV1MoviesIdHistory(w http.ResponseWriter, r *http.Request, id int64)
+	// Get the revision which produced a version of a movie
+	// (GET /v1/movies/{id}/history/{version})
+	GetV1MoviesIdHistoryVersion(w http.ResponseWriter, r *http.Request, id int64, version int32)
+	// Restore a movie from the trash
+	// (POST /v1/movies/{id}/restore)
+	PostV1MoviesIdRestore(w http.ResponseWriter, r *http.Request, id int64)
+	// Revert a movie to a previous version
+	// (POST /v1/movies/{id}/revert/{version})
+	PostV1MoviesIdRevertVersion(w http.ResponseWriter, r *http.Request, id int64, version int32)
+	// List people
+	// (GET /v1/people)
+	GetV1People(w http.ResponseWriter, r *http.Request, params GetV1PeopleParams)
+	// Add a person
+	// (POST /v1/people)
+	PostV1People(w http.ResponseWriter, r *http.Request)
+	// Delete a person
+	// (DELETE /v1/people/{id})
+	DeleteV1PeopleId(w http.ResponseWriter, r *http.Request, id int64)
+	// Get a person by ID
+	// (GET /v1/people/{id})
+	GetV1PeopleId(w http.ResponseWriter, r *http.Request, id int64)
+	// Update a person
+	// (PATCH /v1/people/{id})
+	PatchV1PeopleId(w http.ResponseWriter, r *http.Request, id int64)
+	// Create an authentication token
+	// (POST /v1/tokens/authentication)
+	PostV1TokensAuthentication(w http.ResponseWriter, r *http.Request)
+	// Register a new user
+	// (POST /v1/users)
+	PostV1Users(w http.ResponseWriter, r *http.Request)
+	// Activate a user
+	// (PUT /v1/users/activated)
+	PutV1UsersActivated(w http.ResponseWriter, r *http.Request)
+	// List the permissions of a user
+	// (GET /v1/users/{id}/permissions)
+	GetV1UsersIdPermissions(w http.ResponseWriter, r *http.Request, id int64)
+	// Grant a permission to a user
+	// (POST /v1/users/{id}/permissions)
+	PostV1UsersIdPermissions(w http.ResponseWriter, r *http.Request, id int64)
+	// Revoke a permission from a user
+	// (DELETE /v1/users/{id}/permissions/{code})
+	DeleteV1UsersIdPermissionsCode(w http.ResponseWriter, r *http.Request, id int64, code string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetV1Genres operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Genres(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1Genres(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1Genres operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Genres(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1Genres(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteV1GenresSlug operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1GenresSlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", r.PathValue("slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1GenresSlug(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1GenresSlug operation middleware
+func (siw *ServerInterfaceWrapper) GetV1GenresSlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", r.PathValue("slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1GenresSlug(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchV1GenresSlug operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1GenresSlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", r.PathValue("slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchV1GenresSlug(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1Movies operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Movies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1MoviesParams
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", r.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "title", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "genres" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "genres", r.URL.Query(), &params.Genres)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "genres", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "year_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year_min", r.URL.Query(), &params.YearMin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year_min", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "year_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year_max", r.URL.Query(), &params.YearMax)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year_max", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "runtime_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "runtime_min", r.URL.Query(), &params.RuntimeMin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runtime_min", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "runtime_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "runtime_max", r.URL.Query(), &params.RuntimeMax)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runtime_max", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "person_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "person_id", r.URL.Query(), &params.PersonId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "person_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1Movies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1Movies operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Movies(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1Movies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesExport operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1MoviesExportParams
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", r.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "title", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "genres" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "genres", r.URL.Query(), &params.Genres)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "genres", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "year_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year_min", r.URL.Query(), &params.YearMin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year_min", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "year_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year_max", r.URL.Query(), &params.YearMax)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year_max", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "runtime_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "runtime_min", r.URL.Query(), &params.RuntimeMin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runtime_min", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "runtime_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "runtime_max", r.URL.Query(), &params.RuntimeMax)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runtime_max", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "person_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "person_id", r.URL.Query(), &params.PersonId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "person_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1MoviesImport operation middleware
+func (siw *ServerInterfaceWrapper) PostV1MoviesImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1MoviesImportParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1MoviesImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1MoviesSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesTrash(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteV1MoviesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1MoviesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1MoviesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchV1MoviesId operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1MoviesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchV1MoviesIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "X-Expected-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Expected-Version")]; found {
+		var XExpectedVersion int32
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Expected-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Expected-Version", valueList[0], &XExpectedVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Expected-Version", Err: err})
+			return
+		}
+
+		params.XExpectedVersion = &XExpectedVersion
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchV1MoviesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesIdCredits operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesIdCredits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesIdCredits(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1MoviesIdCredits operation middleware
+func (siw *ServerInterfaceWrapper) PostV1MoviesIdCredits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1MoviesIdCredits(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteV1MoviesIdCreditsCreditId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1MoviesIdCreditsCreditId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "creditId" -------------
+	var creditId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "creditId", r.PathValue("creditId"), &creditId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creditId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1MoviesIdCreditsCreditId(w, r, id, creditId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesIdHistory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1MoviesIdHistoryVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetV1MoviesIdHistoryVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1MoviesIdHistoryVersion(w, r, id, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1MoviesIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostV1MoviesIdRestore(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1MoviesIdRestore(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostV1MoviesIdRevertVersion operation middleware
+func (siw *ServerInterfaceWrapper) PostV1MoviesIdRevertVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1MoviesIdRevertVersion(w, r, id, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// Movie defines model for Movie.
-type Movie struct {
-	Genres []string `json:"genres"`
-	Id     int64    `json:"id"`
+// GetV1People operation middleware
+func (siw *ServerInterfaceWrapper) GetV1People(w http.ResponseWriter, r *http.Request) {
 
-	// Runtime Runtime in minutes, formatted as "X min"
-	Runtime string `json:"runtime"`
-	Title   string `json:"title"`
-	Version int32  `json:"version"`
-	Year    int32  `json:"year"`
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PeopleParams
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1People(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateMovieRequest defines model for UpdateMovieRequest.
-type UpdateMovieRequest struct {
-	Genres     *[]string `json:"genres,omitempty"`
-	RuntimeMin *int32    `json:"runtimeMin,omitempty"`
-	Title      *string   `json:"title,omitempty"`
-	Year       *int32    `json:"year,omitempty"`
+// PostV1People operation middleware
+func (siw *ServerInterfaceWrapper) PostV1People(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1People(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// PostV1MoviesJSONRequestBody defines body for PostV1Movies for application/json ContentType.
-type PostV1MoviesJSONRequestBody = CreateMovieRequest
+// DeleteV1PeopleId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PeopleId(w http.ResponseWriter, r *http.Request) {
 
-// PatchV1MoviesIdJSONRequestBody defines body for PatchV1MoviesId for application/json ContentType.
-type PatchV1MoviesIdJSONRequestBody = UpdateMovieRequest
+	var err error
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List all movies
-	// (GET /v1/movies)
-	GetV1Movies(w http.ResponseWriter, r *http.Request)
-	// Create a new movie
-	// (POST /v1/movies)
-	PostV1Movies(w http.ResponseWriter, r *http.Request)
-	// Get a movie by ID
-	// (GET /v1/movies/{id})
-	GetV1MoviesId(w http.ResponseWriter, r *http.Request, id int64)
-	// Update a movie
-	// (PATCH /v1/movies/{id})
-	PatchV1MoviesId(w http.ResponseWriter, r *http.Request, id int64)
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PeopleId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// GetV1PeopleId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PeopleId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PeopleId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// PatchV1PeopleId operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1PeopleId(w http.ResponseWriter, r *http.Request) {
 
-// GetV1Movies operation middleware
-func (siw *ServerInterfaceWrapper) GetV1Movies(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1Movies(w, r)
+		siw.Handler.PatchV1PeopleId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -85,11 +1639,11 @@ func (siw *ServerInterfaceWrapper) GetV1Movies(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// PostV1Movies operation middleware
-func (siw *ServerInterfaceWrapper) PostV1Movies(w http.ResponseWriter, r *http.Request) {
+// PostV1TokensAuthentication operation middleware
+func (siw *ServerInterfaceWrapper) PostV1TokensAuthentication(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1Movies(w, r)
+		siw.Handler.PostV1TokensAuthentication(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -99,8 +1653,36 @@ func (siw *ServerInterfaceWrapper) PostV1Movies(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetV1MoviesId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1MoviesId(w http.ResponseWriter, r *http.Request) {
+// PostV1Users operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Users(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1Users(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutV1UsersActivated operation middleware
+func (siw *ServerInterfaceWrapper) PutV1UsersActivated(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV1UsersActivated(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetV1UsersIdPermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetV1UsersIdPermissions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -113,8 +1695,14 @@ func (siw *ServerInterfaceWrapper) GetV1MoviesId(w http.ResponseWriter, r *http.
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1MoviesId(w, r, id)
+		siw.Handler.GetV1UsersIdPermissions(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -124,8 +1712,8 @@ func (siw *ServerInterfaceWrapper) GetV1MoviesId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PatchV1MoviesId operation middleware
-func (siw *ServerInterfaceWrapper) PatchV1MoviesId(w http.ResponseWriter, r *http.Request) {
+// PostV1UsersIdPermissions operation middleware
+func (siw *ServerInterfaceWrapper) PostV1UsersIdPermissions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -138,8 +1726,54 @@ func (siw *ServerInterfaceWrapper) PatchV1MoviesId(w http.ResponseWriter, r *htt
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1UsersIdPermissions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteV1UsersIdPermissionsCode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1UsersIdPermissionsCode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", r.PathValue("code"), &code, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchV1MoviesId(w, r, id)
+		siw.Handler.DeleteV1UsersIdPermissionsCode(w, r, id, code)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -269,10 +1903,213 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/v1/genres", wrapper.GetV1Genres)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/genres", wrapper.PostV1Genres)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/genres/{slug}", wrapper.DeleteV1GenresSlug)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/genres/{slug}", wrapper.GetV1GenresSlug)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/genres/{slug}", wrapper.PatchV1GenresSlug)
 	m.HandleFunc("GET "+options.BaseURL+"/v1/movies", wrapper.GetV1Movies)
 	m.HandleFunc("POST "+options.BaseURL+"/v1/movies", wrapper.PostV1Movies)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/export", wrapper.GetV1MoviesExport)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/movies/import", wrapper.PostV1MoviesImport)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/search", wrapper.GetV1MoviesSearch)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/trash", wrapper.GetV1MoviesTrash)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/movies/{id}", wrapper.DeleteV1MoviesId)
 	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/{id}", wrapper.GetV1MoviesId)
 	m.HandleFunc("PATCH "+options.BaseURL+"/v1/movies/{id}", wrapper.PatchV1MoviesId)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/{id}/credits", wrapper.GetV1MoviesIdCredits)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/movies/{id}/credits", wrapper.PostV1MoviesIdCredits)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/movies/{id}/credits/{creditId}", wrapper.DeleteV1MoviesIdCreditsCreditId)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/{id}/history", wrapper.GetV1MoviesIdHistory)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/movies/{id}/history/{version}", wrapper.GetV1MoviesIdHistoryVersion)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/movies/{id}/restore", wrapper.PostV1MoviesIdRestore)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/movies/{id}/revert/{version}", wrapper.PostV1MoviesIdRevertVersion)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/people", wrapper.GetV1People)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/people", wrapper.PostV1People)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/people/{id}", wrapper.DeleteV1PeopleId)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/people/{id}", wrapper.GetV1PeopleId)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/people/{id}", wrapper.PatchV1PeopleId)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/tokens/authentication", wrapper.PostV1TokensAuthentication)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/users", wrapper.PostV1Users)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/users/activated", wrapper.PutV1UsersActivated)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/users/{id}/permissions", wrapper.GetV1UsersIdPermissions)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/users/{id}/permissions", wrapper.PostV1UsersIdPermissions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/users/{id}/permissions/{code}", wrapper.DeleteV1UsersIdPermissionsCode)
 
 	return m
 }
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+w9a3PbtpZ/BcO9M9vupW0lze1t/c1N3Fzv1mnGdjp3J8p6YPJIQkMCLABaVjP+7zsH",
+	"Dz4hifJDdmp/SWQ+gIPzfgH8EiUiLwQHrlW0/yWSoArBFZg/DqUUEn8kgmvgGn/SoshYQjUTfK+Q4iKD",
+	"/O+/K8HxnkpmkFP89TcJk2g/+o+9evQ9e1ftvbdvRdfX13GUgkokK3C4aD/6wOGqgERDSsDMfR1HPwt5",
+	"wdIU+DYBOZsBKRVIwhThQhOaaHZJES4hSUaTz4roGRAJf5RMQkoKkDlTCl++jqMPnJZ6JiT7E9JtQn2M",
+	"IPApwsj4Jc1YShAQ4NpNSbT4DA7EQooElKIXGRxyzfRi2/hF5IHSZEJZBikx8JrpYoNbv4IJgyxVhEog",
+	"GVNIAsYtd6gIB3azITAHjkofFMgTOzpeLqQoQGpmmdqiYP9LlNOrX4BP9Szaf/l9HOWMN//UiwKi/Uhp",
+	"yfjUTOSJHe1/dIN8qh4TF79DohGxBy2En/nJ2jDAVcGkQfhEyJzqaD9KqYYdzXKIenPHNcxtLJ4CTwlV",
+	"ZBwdOH4zk+6Tn4BKkGRcjkbfJeZl8xPGUTRsZbEHMbTE1xKohsBCl+Iccsoy/NFbWUGVmguZtnBRXVwH",
+	"rB23McpycF9LSJleCuEFyzLGp7/KFGQf0e+FYviT0FzwqWHPxIyniJiYP3NxySAmdKJBmgtwxZRGYfQP",
+	"zmfAiciZ1oALqxbLuP7uZRQjO7K8zKP9F6PRyLCj+7NaEuMapmCUYjKjkiY6BOs7moOHqnosJoJnCzIR",
+	"ElWZkMpOWDH8aFTN0qANSCX4UZs2jOvvX0UhmKTIYJ1KcFTAJ7u0rGZzIy0n5VvgEpZSkmaMOvvVRsyv",
+	"egaSqAIMpSvKTXG0mCgtUJFTRVRWThE/TENuhmlohhcBNOX06sg++rLGIpWSLvAupzl0tM0LR99VgyIQ",
+	"ndf+0XuroFqDxLX930e68+do58dPf/9mp/r57X/9ba0EmXkclMsxfozcvRTjBoMBhJ8iItEYOZIgxu2z",
+	"qMINf1JNMzEtV+N/Bcb/YVDi/njRwX4clZz9UYK7rWUJuPqSo5o9ZrzL11YMV8qdZjqDHl3Wk3MBNCCq",
+	"x6XSxr24AI+RSalLCVG8CrAffvihD1tXjRtA3cStRceeXsvJ/d6I4gpdKfXsf+90RaNRf0VB2Xm5Ftkd",
+	"RKzi7JQNsAQ9sO9MGyvQbY3cYxs2VPduqKrt4+8cenvT3lKTszTyUxylUWs2N3bcxvJy8pw4SIAjn3yM",
+	"UiYBsRXF0VwyxHiM5EvLxPyk7p6BV5lLCeOQUy2mkhYzcwXHFc1J64Uby3ILkzKfsWRmXNVanSHlUaVZ",
+	"B8D4CdabxQVo4Cs13TJr0gbjDVNFRheE07D76I1J+62jFLhmEwYWRqMVvNA6NS0mDuCN7EhcISxE2LeS",
+	"cv2+ipeWaplEpC3SW0D2DdktU7kR1D5Nc8ajT+tgNCOGQDrKCyH1CeC/AeonCRQa0j4G35X5BUjEkg1V",
+	"pJiroLwxM8HqIRxnMK5AWhexP07ewQnNsnMhz7nQM1xwHF2A0ucwmeBCQgwu4XcTXq8ChPE1qzE39r/U",
+	"bLtKTzjcinmfnTvkMYtr4CquMd8A3E2/goxi3qehixeRmmlqXHmavW890UNVR1ja0WhOiwJSogWZz6gm",
+	"TJG5xMhgzvQMBSiPyRgBHUdW7E2yQMxJIsos9QayoFKhjtCEZlkUWFDGeEDaf2G8MikXIl1UgytNpVZE",
+	"8CDVlKa6VC3uCaB3rRAZmKrRQmQ4Bk1TqmkgjKJTxm0iIgVNWaZiAnmhFxWSJBjVyAWRoMpMK6vfm2qh",
+	"lBK4Pi/oFAaa5wmTaqMXMrrZ8xyu9HlSSiUCHsBrc90YeiQUPks+wwKNP04R+5iQCMsmOLm5E9LkeP1c",
+	"sT+HQqaFptm5hETIVA1yDq5DFEXV1BeqFDLQkB7o/qLPWA51XEzmVOEvKzF4WUuqZh03yA1XW5xhuZE6",
+	"+ringGGwE+ac7D42TuwNNK4546UGFRM7ngaXwPk33gmlZ1bEHL0nL0GaFOQw3th6TGL8Qg9jvCREWR2f",
+	"uED0kvl1dpTDjPIppD8bJd1fm73eStkQ9wq5cHrUj72JV5aYqMkJwjCuzb1IrbKdVu6u4wjXSLVbcj+T",
+	"atfgvE/nDqet5cREcVqomTC2Cu+gAoeG32FfNlkrxAZMhLQSPGNKC7kwMmwVibEY3ojYtaPoFKn9YeXY",
+	"GBV80/66BKmjOPJQBJ0TLWkCRwHf5AxvkKM3nnI+c2xhzmkKPr7iYbVZKpChgTFRTOYz0R0jNhLgFZO9",
+	"puxDotSKpZUBPnh/FMVDlMMmstmRm1pkaj7wHBR3eL7GYpMtQ6JUu+DqxBV9+gLV8LJXa9iVnl1zlCWg",
+	"KME3SjT86oxm5V2ZZwnqEuTwkn/mYs5DOqtPmsEKnocD5pCaW5p28EWRvpH4+TX58dU//klcscW7SH0P",
+	"yAUBnWSbxkoOyWkyYxx2JNDUXDCeL0msb+2F9oKm506IUCVXlZdzW4tB+IU+n4iSp8YoqrKwTvl5Dimj",
+	"52ZV9iHrROJUyJOgZyI9N9ezTMwhdfH2eSL4JGOJNsF59dOFGueYIweuGc1U46ovRbRrWOcVrvFJU5gD",
+	"hEKUXFt6u3JhHEmq4TxjOdPncJUApO4lDZLT7NygJqiJLOr7SD68KjLqPFhVQMImLLEuDVNEJNY3TSrl",
+	"4CgZ0kgPEJC0nK0QzXu8yrjSlCcwWCMbt4ZMGE+x8sG09yAyMQ3mt+qYpD38v87O3hN7s2LdK5oX6Ae9",
+	"CucKKy+pIxYzITVRZZ5TuejQhTg2roaOfqIpOankYomS6xmRkyPCbC5lwfi0N0NMUpAMnd+JFLm53V1T",
+	"VEq+797ZwXf2V9BnWeHOLsZ7VQ618fKsxwlMmdIgV1ZLl1fuAsnZIZnwdQW/xnD/fNka7YdBqd54WFHw",
+	"FKhMZicmyOyvejPvTCVChrx+yOCSNtSBGdVHQH+UIBcxmbHpzLYZXIC2+cwKK5NM0AYfcpOhMRNyVhSg",
+	"Q3Jz/AuZSDrNgWs/reGIShGQnGo0EVOCuEE1YTUH465cnFP52fwCoulU7ZKzagymCM6wAyqhBaQxUXYt",
+	"1YwJ5Rg3SOApuPQnvrC7lnm9M2NRWa8wRLoPxtG8YRHwBIqMJqAw5WJgb9SmqsTnwxb/rpeu+bkM9zWU",
+	"4ZYQ77EU1ZIMqPxp+WwngJka1XWqvek0q4iNpDuQEpFfMA6pVTH1OqrJL4TIgPLbVPT6OFUg+0is2rQa",
+	"/NqY/QZx+nL7dx9RQw1g3LVm9dL6OhFNAiSlZHpxisbJcZRpAsI2nYD/hq619Ufe/3p6RvYuX+wZd1vt",
+	"tb3tyDVYGUyaAes1zrQubHMX4xMR4KTD0zMMkI1TmFOO2d9po6BkhdjmdJSLpKswOXqxO9odueQHpwWL",
+	"9qPvzCXT9zAzS0Swa7U3tSaxCpIx4o/egv7txVv7TNxub3w5Gq3oeev3ui1Tt4PqIAaEYKTcJWbP1X/r",
+	"inHSGtWLhdHNONar0Ytl81Yr3Wt1I5qXvlv/Ut11acCZUOcnrX7LNowafrQetylXKN0oMFa2BgcuhAqQ",
+	"7L1QbZoZlfmTSBcbkWtN9brrQ1y3JdKbqTbDvLgtwwxkk8FsQZzKIKpMElBqUmYZmtwZ0BRsgPmLSJbk",
+	"Dj+c/FL1JrhhvP9TL6Krtq63x3evXr4cMk2/k/W2PHuQpoQ6hnX+eoNvr+OG4tn7gsJ4XddD+mh28uuy",
+	"lVbbzeglxITxJCtTHzS6W4w3SyQNQ2vHT3ejuCMwb8wNLzKnthRfUElz0IYJPn6JGDdxlp5507Lva/Zt",
+	"nl9F+k93qkBzpNl0iWkcyP2+ZtTi/i0y6OjVNrum7ZqRG2xqzkDw41Y7zGvubYRLtxU3y7+VxFGeEqaV",
+	"j1dskW+1af9aeP6ubcBZkwZPhulvxWxvQVec1nClCkyLBHwRvLwlLrt7NyeQKhnk5owenZtjq4tPWdE/",
+	"lC90AsjUlcgISaTNobV1tHOKXHS3Mho79hFgR5A6FT4sV0jQpeR1jVoon4tEUlDGla29XNKsBPJNQhXs",
+	"MK6AK4Z1oW9NuSfaj0y2tZbPKkm+VCDjQeCgQ2dsIWYTrSetfPNkTKbsErjXMVUCzu6tyUwRb0IzBWEQ",
+	"pz78qWEc3pCg9MKE1pidiHAtoRkwrXOeM96aY0BtetVo9Grz0bp72Ez+ishez8wSWroHb7aSztz06oZz",
+	"337dAf4S3LGYYXGbeSNMuR1FkC4Byj54ztKlIH3/ahBIp0Jqm3WISSFhwq5sim8c7Ywj17KlEjDFviWw",
+	"KCF1C4xKFdmEly9Imz+WNgTtmNs7/v6Oe2DHPxFqWgxzqeurC8DzYt2WrNHabVmrJrVNe8GZX47WTL1+",
+	"1qVtlrlIYZf8T9Vu6K+zKRcSFF4CYgtLyvrdXGmg6ZhbVlSEkkZ3o+NHCarMXYo4c9vc6p1vpoHRsPDu",
+	"mC/j0QqUJdwhJhMFusEh1QXbO7mE5h2ZKugfJbQWYFKeFNn5kolStTsxWV5kDJoXayBDq7BjRtsMmevW",
+	"2pWlSf+c7zQbnqesqpqb5ylNpq/eMmDcptE23SYs3/u2lieQpTL4rpG9KplauVz3l0xtFSfvPZm6SYV+",
+	"CO8e22bQu0mmcphni2qwura9OqX6LCr3JyqWRY0xmzuCtCOWPbjyu3+moc6KUy2B5orAJciFHaHupDAV",
+	"WZZpkCquKrTGopMEQxbuuovH/MB0yxHLUOSb/z799Z1tYWSmZyu3Bzh8u0uO631iCrh2G8kWeAFtM200",
+	"E6Giv6AKqnYMuxR/dIXpf6sbnNHSjTl6I7vkgHis+fZfjh0hTM9EqU14Z2w57i3B7t+k1ESZfqqLBaF8",
+	"zG1vYXPPu5nY7EOB1Fr/pfHfoXl4UBToxn0sUWAXnOco8DkKvMsosM1fz1HgzaPAO/a+78qT7nW+qXBT",
+	"RxO4qx2e9gEMFM7gSu8l6nL1c8FCggVll7w+/Y3MKMaDhKWxwXeMyI4dpmOn2lyvhrNmY161GPYlx8SW",
+	"jY3FichzShSg7tfYSchM8+CYY4uT2wBK6JQyvkvevTFmEiESHKxlRDkgGeMuxGw4aK8tbXdwT7Q7tSVg",
+	"zcvpFMxGlwnLwOyb9r5bKuY8EzR9NDXw0ffb9AvfiXpzqen1Ny3MVVG67b+4dk+/4+hBPbzDltKkCrk4",
+	"9rwjJMH/uz6f5TQj6C52Cjt9blCXO0DxQLaJrR6kHhuc5t4ZtDJjGufiMffigDJQ8X9W5lyRb8Ly8G1M",
+	"XK5izDGYwB0dKXhPrh9vEas01C45NP4pemzWH2KmbDLmGfuM7m8rJqmEst7vbRxOvwUdiX6BPq6lP0W3",
+	"kU8z05rAFU0QS7vkiJP2NnSTdCL+D6ZqgUZPd8wprwD0246N19zYu26HCIHmhhpzD7sfAnWDIuozw27m",
+	"kOvZDISP8rDvGTJeblt6yHj1tt/fcF/+8PJf2ApUVv2CcWpgH2oX1r95z9VCWR26MOAQAfts14K6IT4N",
+	"jfJrhowtY1UsFGLC9rlzluMsGFuP2c/8hv/WKQIYDMaYsZih1rPqyYdwrTMKnc7Zfrj/GFkjvl9SnYoc",
+	"LHPNQUKLw0LKEpWZvzCnNYPe2ipa7LQMGPKHkM4ydi2iMttilmZBfi6zbAc1CbEPEnEJ0u9rQZtXHYGT",
+	"KWHzI6bIIDWjmd1wYjV3zlR1as4usdtwrIZvdLlKv4FmTSbB7uUZps3/WNmWsWFbengKY6bvp9Tz6Y51",
+	"r0H74ICmtWfqRhWCY58xs+wWk1wo7emsiTmo47lucN+usqWjVwoXCyu6XVVg+k+HNHKcmQcfZ6Q9uGbV",
+	"OXzka+qtD7QNd2n5haWdDuVwA7HzjtNBTW4mHbNcl65PNT2mduJjm9HvnVHz1+0xsyu+u77KY4EZ8PYm",
+	"1wqF63TIV8py91KE9McuNJJah2c0cIDfby7/1j6CuDSmAtXB0WTHGFxbYTIdlHZb/uPJbX3VHG87iS3D",
+	"XyzI0ZtWH3HgQHmkgNlFbY5iMGvFmKBBPpPltIEd8OpsIsW4abccc3sID03truzqsHyfifUJOaoUpHg7",
+	"YwnT2cJmqjw/jPk3pfKpKmQt13dmve63h2ffYoDw751DN/yOY7RgVsX2R29Xins1CrMIJwYeF7qFcSyQ",
+	"pqTu5LHCVcPkcbNZOfC3G83Vx+yGZaN77RbfvI/j8anQYLf45hrVD/PcwfGIVPaWdzydtQ4xbGtkHdDB",
+	"DxrZWQn2RikUBey5zxEMCeyO0tfu4a/QN2usc1AMaZd6syDydeBbECaZbA+5tjmt50hi4x3jCVXa5EYT",
+	"CXPEbsXY8ZKKnacEddtnCiqrc3D8QYYtKuHoqTDPWtk2bU9OlndXF5EeQDzuq3mz/XWUe+/eTKqz94eI",
+	"5HARDDdwPinr+IAdlkgA6juUBB9ihva+2B9Hm+WnnOC9du9uLeoIDJvUMPxVcmGOlE9rb72VJSFdX90d",
+	"mjN7oBKhfmTXRrJcNpyhWlqFsz0ezlxp4ccirD6g15aC/em/ZCay6pQJc/DvmLetYNXCW51/C3NQriJj",
+	"kw0N8+k4Y8wt1CbtoDTLMl+PXlOwO0r/5Zb4FTqWHqkblieqQ6tv5GH6t9s+5rNLubFLKZuIHCCEe1+c",
+	"L3g9LGJyjF3ndR7MMNWnRg8be0Wq6c5lZ0OJGXoShmzI2F/cSvks591ms5sS0j1OnlZzrpEcf+x7o61y",
+	"VRB14h5/rv846jr8PdEgplPHvrXvZXBZuUjVpqmlZfI9+62Ctt4PJxsOCrtdGMerPq/QrUKIppul3A40",
+	"dzce82ZiguHO40LvkjetZgR32pKwzpXjDeuYrWtzTU/MYp6t0VbF99J2T9+y+FCNM7T68PTs3aMoBcxn",
+	"DMvt2ly8AAyzPOke+NweBKLZkdE49qAqVjgFWIAoMljt4r63z2xwbI8d1W3YNJtsbrVf0/y3pj77VA79",
+	"ePhzJWqWGRQEu2+83Cj6tZzXbA02SH4qRzw4TK854qESz/urErSPML/3KkFRfRZoCGMNYyR89q7PzHVw",
+	"Ph+am1bp/45hCTSgBiUcP8VFJVR7m4ngVav4HZ+Va2d8iq2uTgieVnrfLfqh3cf+9v2axe/uGN2isrXr",
+	"vLmvk//v3jDUtHlaInAH/a+OpfsNsMFG0Qdgu/tqlLyBNzR6jN7Q0zpaN2gIHrxfr+M1hT/FsjQReXjl",
+	"P1FKSalA/qc1L+6LiuaMFkrs11uI/6hiKIQwX4VRB+1Z7zOgaE9lpt9adNH5qqTFyxqoA/AOE7X2i5YI",
+	"Pn54NBsetzW7/3Rlg0cfQgrdF5Oi/Y+fQkfFcUIDRKtEFOVMLRdJO4o9Q8d9pNSIpmk4VOacNT2D3EyT",
+	"mC871VOEhfODmfB+pDH0KchBAnib/f2l+4TXSkurwh9V6zMVPkmkWwekcQ+tiPXHsLn4cXC5p7grSJUe",
+	"zxVr77W+pVaUobxT6dnyoHr2fvjTj78xf44eF39WOA04Ww9rALYaCR+aVl3/GejHIxSezZwb1REIUx3u",
+	"fBA92J93YplS+Q8m+hf2aZoz3rgS7pEzEnWUNr7PftvN9WsCht5n4MNus3+MTCXlut4nbVH1V40XjNze",
+	"QyNagy9sQ41H43bC4rDXcnPObfgofda9e4PwFlmwnuYebcK2ZOd57+D9S+6DxfmGXW2Y7zjB1uMHWJm9",
+	"L4lIYWXx5OZS64shfbl9bU+9e7htFXb+7X2n7Y7l3LbDP3FL+ZC9L+IztAXObbtwItf2AtsfS/74CblJ",
+	"gbwMt7hgcTgjKVxCJgrz3Xv7bBRHpczch5H39/YyfG4mlN5H9X796fr/BwC640JXbaEAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"k8s.io/utils/ptr"
+)
+
+func (s Server) GetV1People(w http.ResponseWriter, r *http.Request, params GetV1PeopleParams) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		people, metadata, err := s.ms.ListPeople(ctx, service.PeopleFilters{
+			Name:     ptr.Deref(params.Name, ""),
+			Page:     ptr.Deref(params.Page, 0),
+			PageSize: ptr.Deref(params.PageSize, 0),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		apiPeople := make([]Person, len(people))
+		for i, p := range people {
+			apiPeople[i] = toAPIPerson(p)
+		}
+
+		return srvx.Envelope{"people": apiPeople, "metadata": toAPIMetadata(metadata)}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1People(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput CreatePersonRequest) (srvx.Response[srvx.Envelope], error) {
+		person, err := s.ms.CreatePerson(ctx, service.PersonInput{
+			Name:      apiInput.Name,
+			BirthYear: apiInput.BirthYear,
+		})
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("created person", "id", person.ID)
+
+		headers := make(http.Header)
+		headers.Set("Location", fmt.Sprintf("/v1/people/%d", person.ID))
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusCreated,
+			Header: headers,
+			Body:   srvx.Envelope{"person": toAPIPerson(person)},
+		}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1PeopleId(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		person, err := s.ms.GetPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return srvx.Envelope{"person": toAPIPerson(person)}, nil
+	})(w, r)
+}
+
+func (s Server) PatchV1PeopleId(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput UpdatePersonRequest) (srvx.Envelope, error) {
+		person, err := s.ms.UpdatePerson(ctx, id, service.PartialPersonUpdate{
+			Name:           apiInput.Name,
+			BirthYear:      apiInput.BirthYear,
+			ClearBirthYear: ptr.Deref(apiInput.ClearBirthYear, false),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("updated person", "id", person.ID)
+
+		return srvx.Envelope{"person": toAPIPerson(person)}, nil
+	})(w, r)
+}
+
+func (s Server) DeleteV1PeopleId(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		if err := s.ms.DeletePerson(ctx, id); err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("deleted person", "id", id)
+
+		return srvx.Envelope{"message": "person successfully deleted"}, nil
+	})(w, r)
+}
+
+func (s Server) GetV1MoviesIdCredits(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		credits, err := s.ms.ListMovieCredits(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		apiCredits := make([]Credit, len(credits))
+		for i, c := range credits {
+			apiCredits[i] = toAPICredit(c)
+		}
+
+		return srvx.Envelope{"credits": apiCredits}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1MoviesIdCredits(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput CreateCreditRequest) (srvx.Response[srvx.Envelope], error) {
+		credit, err := s.ms.AddMovieCredit(ctx, id, service.CreditInput{
+			PersonID:     apiInput.PersonId,
+			Role:         string(apiInput.Role),
+			Character:    ptr.Deref(apiInput.Character, ""),
+			BillingOrder: ptr.Deref(apiInput.BillingOrder, 0),
+		})
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("added credit", "movie_id", id, "credit_id", credit.ID, "person_id", credit.PersonID)
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusCreated,
+			Body:   srvx.Envelope{"credit": toAPICredit(credit)},
+		}, nil
+	})(w, r)
+}
+
+func (s Server) DeleteV1MoviesIdCreditsCreditId(w http.ResponseWriter, r *http.Request, id int64, creditId int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		if err := s.ms.DeleteMovieCredit(ctx, id, creditId); err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("deleted credit", "movie_id", id, "credit_id", creditId)
+
+		return srvx.Envelope{"message": "credit successfully deleted"}, nil
+	})(w, r)
+}
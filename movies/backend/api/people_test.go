@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestPeopleAndCredits(t *testing.T) {
+	db := mocks.NewMockQueries()
+	movieServer := NewServer(service.New(db), nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	// The cases run in order against the same data.
+	db.Reset(mocks.TestMovie1)
+
+	tcs := []struct {
+		name           string
+		method         string
+		url            string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expectedHeader string
+		expectedBody   string
+	}{
+		{
+			name:           "create person",
+			method:         http.MethodPost,
+			url:            "/v1/people",
+			body:           `{"name": "Etienne Comar", "birthYear": 1965}`,
+			expectedStatus: http.StatusCreated,
+			expectedHeader: "/v1/people/1",
+			expectedBody:   `"person":{"birthYear":1965,"id":1,"name":"Etienne Comar"}`,
+		},
+		{
+			name:           "create person with a birth year in the future",
+			method:         http.MethodPost,
+			url:            "/v1/people",
+			body:           `{"name": "Reda Kateb", "birthYear": 3000}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedBody:   `"birthYear"`,
+		},
+		{
+			name:           "update person",
+			method:         http.MethodPatch,
+			url:            "/v1/people/1",
+			body:           `{"name": "Étienne Comar"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"name":"Étienne Comar"`,
+		},
+		{
+			name:           "clear birth year",
+			method:         http.MethodPatch,
+			url:            "/v1/people/1",
+			body:           `{"clearBirthYear": true}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"person":{"id":1,"name":"Étienne Comar"}`,
+		},
+		{
+			name:           "set and clear birth year",
+			method:         http.MethodPatch,
+			url:            "/v1/people/1",
+			body:           `{"birthYear": 1965, "clearBirthYear": true}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedBody:   `"birthYear":"must not be set when clearing the birth year"`,
+		},
+		{
+			name:           "add credit",
+			method:         http.MethodPost,
+			url:            "/v1/movies/1/credits",
+			body:           `{"personId": 1, "role": "director"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"credit":{"billingOrder":1,"id":1,"personId":1,"personName":"Étienne Comar","role":"director"}`,
+		},
+		{
+			name:           "add credit with a character for a director",
+			method:         http.MethodPost,
+			url:            "/v1/movies/1/credits",
+			body:           `{"personId": 1, "role": "director", "character": "Django"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedBody:   `"character":"must only be set for actors"`,
+		},
+		{
+			name:           "add credit to a missing movie",
+			method:         http.MethodPost,
+			url:            "/v1/movies/42/credits",
+			body:           `{"personId": 1, "role": "writer"}`,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   srvx.CodeNotFound,
+		},
+		{
+			name:           "list credits",
+			method:         http.MethodGet,
+			url:            "/v1/movies/1/credits",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"credits":[{"billingOrder":1`,
+		},
+		{
+			name:           "movies of a person",
+			method:         http.MethodGet,
+			url:            "/v1/movies?person_id=1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"title":"Django"`,
+		},
+		{
+			name:           "movies of a person without credits",
+			method:         http.MethodGet,
+			url:            "/v1/movies?person_id=2",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"movies":[]`,
+		},
+		{
+			name:           "delete a credited person",
+			method:         http.MethodDelete,
+			url:            "/v1/people/1",
+			expectedStatus: http.StatusConflict,
+			expectedCode:   srvx.CodeConflict,
+		},
+		{
+			name:           "delete credit",
+			method:         http.MethodDelete,
+			url:            "/v1/movies/1/credits/1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "delete a missing credit",
+			method:         http.MethodDelete,
+			url:            "/v1/movies/1/credits/1",
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   srvx.CodeNotFound,
+		},
+		{
+			name:           "delete person",
+			method:         http.MethodDelete,
+			url:            "/v1/people/1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "get a missing person",
+			method:         http.MethodGet,
+			url:            "/v1/people/1",
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   srvx.CodeNotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+
+			code, header, rsBody := ts.Do(t, tc.method, tc.url, body)
+			if code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, code, rsBody)
+			}
+			if tc.expectedCode != "" {
+				var problem srvx.Problem
+				if err := json.Unmarshal([]byte(rsBody), &problem); err != nil {
+					t.Fatal(err)
+				}
+				if problem.Code != tc.expectedCode || header.Get("Content-Type") != srvx.ProblemContentType {
+					t.Errorf("expected a %s problem, got %s", tc.expectedCode, rsBody)
+				}
+			}
+			if tc.expectedHeader != "" && header.Get("Location") != tc.expectedHeader {
+				t.Errorf("expected the location %s, got %s", tc.expectedHeader, header.Get("Location"))
+			}
+
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, []byte(rsBody)); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(compact.String(), tc.expectedBody) {
+				t.Errorf("expected the body to contain %s, got %s", tc.expectedBody, rsBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+func (s Server) GetV1UsersIdPermissions(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		permissions, err := s.us.GetPermissions(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return srvx.Envelope{"permissions": permissions}, nil
+	})(w, r)
+}
+
+func (s Server) PostV1UsersIdPermissions(w http.ResponseWriter, r *http.Request, id int64) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput GrantPermissionRequest) (srvx.Envelope, error) {
+		permissions, err := s.us.GrantPermission(ctx, id, string(apiInput.Code))
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("granted permission", "userID", id, "code", apiInput.Code)
+
+		return srvx.Envelope{"permissions": permissions}, nil
+	})(w, r)
+}
+
+func (s Server) DeleteV1UsersIdPermissionsCode(w http.ResponseWriter, r *http.Request, id int64, code string) {
+	srvx.JSON(s.errs, func(ctx context.Context, _ srvx.NoBody) (srvx.Envelope, error) {
+		permissions, err := s.us.RevokePermission(ctx, id, code)
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("revoked permission", "userID", id, "code", code)
+
+		return srvx.Envelope{"permissions": permissions}, nil
+	})(w, r)
+}
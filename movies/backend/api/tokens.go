@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+func (s Server) PostV1TokensAuthentication(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput CreateAuthenticationTokenRequest) (srvx.Response[srvx.Envelope], error) {
+		token, err := s.us.CreateAuthenticationToken(ctx, apiInput.Email, apiInput.Password)
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("created authentication token", "userID", token.UserID)
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusCreated,
+			Body:   srvx.Envelope{"authentication_token": toAPIAuthenticationToken(token)},
+		}, nil
+	})(w, r)
+}
+
+// Authenticator adapts the user service to srvx.Authenticator. The user is
+// stored in the request context as a *service.User.
+type Authenticator struct {
+	us *service.UserService
+}
+
+func NewAuthenticator(us *service.UserService) Authenticator {
+	return Authenticator{us: us}
+}
+
+func (a Authenticator) Authenticate(ctx context.Context, token string) (any, bool, error) {
+	user, err := a.us.AuthenticateUser(ctx, token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return user, true, nil
+}
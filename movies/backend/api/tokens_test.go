@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestCreateAuthenticationToken(t *testing.T) {
+	db := mocks.NewMockQueries()
+	us := service.NewUserService(db, &mocks.Notifier[*service.User]{})
+	movieServer := NewServer(service.New(db), us)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	register := `{"name": "Alice Smith", "email": "alice@example.com", "password": "pa55word"}`
+	if code, _, body := ts.Do(t, http.MethodPost, "/v1/users", strings.NewReader(register)); code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, code, body)
+	}
+
+	tcs := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name:           "valid credentials",
+			body:           `{"email": "alice@example.com", "password": "pa55word"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "wrong password",
+			body:           `{"email": "alice@example.com", "password": "wrong password"}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "unknown email",
+			body:           `{"email": "bob@example.com", "password": "pa55word"}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing password",
+			body:           `{"email": "alice@example.com"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			code, _, body := ts.Do(t, http.MethodPost, "/v1/tokens/authentication", strings.NewReader(tc.body))
+			if code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, code, body)
+			}
+			if code != http.StatusCreated {
+				return
+			}
+
+			var resp struct {
+				AuthenticationToken AuthenticationToken `json:"authentication_token"`
+			}
+			if err := json.Unmarshal([]byte(body), &resp); err != nil {
+				t.Fatal(err)
+			}
+
+			user, ok, err := NewAuthenticator(us).Authenticate(t.Context(), resp.AuthenticationToken.Token)
+			if err != nil || !ok {
+				t.Fatalf("expected issued token to authenticate, got ok=%v err=%v", ok, err)
+			}
+			if user.(*service.User).Email != "alice@example.com" {
+				t.Errorf("expected token to belong to alice@example.com, got %v", user)
+			}
+		})
+	}
+}
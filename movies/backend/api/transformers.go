@@ -1,20 +1,25 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/zbsss/greenlight/movies/backend/service"
+	"k8s.io/utils/ptr"
 )
 
 // toAPIMovie converts a service Movie to an API Movie
 func toAPIMovie(serviceMovie *service.Movie) Movie {
 	return Movie{
-		Id:      serviceMovie.ID,
-		Title:   serviceMovie.Title,
-		Year:    serviceMovie.Year,
-		Runtime: fmt.Sprintf("%d min", serviceMovie.RuntimeMin),
-		Genres:  serviceMovie.Genres,
-		Version: serviceMovie.Version,
+		Id:        serviceMovie.ID,
+		Title:     serviceMovie.Title,
+		Year:      serviceMovie.Year,
+		Runtime:   fmt.Sprintf("%d min", serviceMovie.RuntimeMin),
+		Genres:    serviceMovie.Genres,
+		Version:   serviceMovie.Version,
+		DeletedAt: serviceMovie.DeletedAt,
 	}
 }
 
@@ -32,6 +37,224 @@ func (apiRequest UpdateMovieRequest) toService() service.PartialMovieUpdate {
 		Title:      apiRequest.Title,
 		Year:       apiRequest.Year,
 		RuntimeMin: apiRequest.RuntimeMin,
-		Genres:     *apiRequest.Genres,
+		Genres:     ptr.Deref(apiRequest.Genres, nil),
 	}
 }
+
+// toAPIUser converts a service User to an API User
+func toAPIUser(serviceUser *service.User) User {
+	return User{
+		Id:        serviceUser.ID,
+		CreatedAt: serviceUser.CreatedAt,
+		Name:      serviceUser.Name,
+		Email:     serviceUser.Email,
+		Activated: serviceUser.Activated,
+	}
+}
+
+// toAPIAuthenticationToken converts a service Token to an API AuthenticationToken
+func toAPIAuthenticationToken(token *service.Token) AuthenticationToken {
+	return AuthenticationToken{
+		Token:  token.Plaintext,
+		Expiry: token.Expiry,
+	}
+}
+
+func (apiRequest RegisterUserRequest) toService() service.UserInput {
+	return service.UserInput{
+		Name:     apiRequest.Name,
+		Email:    apiRequest.Email,
+		Password: apiRequest.Password,
+	}
+}
+
+// toAPISearchResult converts a service SearchResult to an API SearchResult
+func toAPISearchResult(result *service.SearchResult) SearchResult {
+	return SearchResult{
+		Movie:   toAPIMovie(result.Movie),
+		Score:   result.Score,
+		Snippet: result.Snippet,
+	}
+}
+
+// toAPIMetadata converts service pagination Metadata to API Metadata,
+// leaving out the fields which do not apply to the pagination mode
+func toAPIMetadata(m service.Metadata) Metadata {
+	var apiMetadata Metadata
+
+	if m.TotalRecords != 0 {
+		apiMetadata.CurrentPage = ptr.To(m.CurrentPage)
+		apiMetadata.FirstPage = ptr.To(m.FirstPage)
+		apiMetadata.LastPage = ptr.To(m.LastPage)
+		apiMetadata.TotalRecords = ptr.To(m.TotalRecords)
+	}
+	if m.PageSize != 0 {
+		apiMetadata.PageSize = ptr.To(m.PageSize)
+	}
+	if m.NextCursor != "" {
+		apiMetadata.NextCursor = ptr.To(m.NextCursor)
+	}
+
+	return apiMetadata
+}
+
+func (params GetV1MoviesParams) toService() service.MovieFilters {
+	var filters service.MovieFilters
+
+	if params.Title != nil {
+		filters.Title = *params.Title
+	}
+	if params.Genres != nil {
+		filters.Genres = *params.Genres
+	}
+	if params.YearMin != nil {
+		filters.YearMin = *params.YearMin
+	}
+	if params.YearMax != nil {
+		filters.YearMax = *params.YearMax
+	}
+	if params.RuntimeMin != nil {
+		filters.RuntimeMin = *params.RuntimeMin
+	}
+	if params.RuntimeMax != nil {
+		filters.RuntimeMax = *params.RuntimeMax
+	}
+	if params.PersonId != nil {
+		filters.PersonID = *params.PersonId
+	}
+	if params.Sort != nil {
+		filters.Sort = string(*params.Sort)
+	}
+	if params.Page != nil {
+		filters.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		filters.PageSize = *params.PageSize
+	}
+
+	return filters
+}
+
+func (params GetV1MoviesExportParams) toService() service.MovieFilters {
+	return service.MovieFilters{
+		Title:      ptr.Deref(params.Title, ""),
+		Genres:     ptr.Deref(params.Genres, nil),
+		YearMin:    ptr.Deref(params.YearMin, 0),
+		YearMax:    ptr.Deref(params.YearMax, 0),
+		RuntimeMin: ptr.Deref(params.RuntimeMin, 0),
+		RuntimeMax: ptr.Deref(params.RuntimeMax, 0),
+		PersonID:   ptr.Deref(params.PersonId, 0),
+		Sort:       string(ptr.Deref(params.Sort, "")),
+	}
+}
+
+// isKeyset reports whether the client asked for keyset pagination
+func (params GetV1MoviesParams) isKeyset() bool {
+	return params.Cursor != nil || (params.Pagination != nil && *params.Pagination == Keyset)
+}
+
+// movieETag formats the movie version as a strong entity tag
+func movieETag(version int32) string {
+	return strconv.Quote(strconv.FormatInt(int64(version), 10))
+}
+
+// expectedVersion returns the version the client based its update on, taken
+// from either If-Match or X-Expected-Version, or nil if neither was sent
+func (params PatchV1MoviesIdParams) expectedVersion() (*int32, error) {
+	var fromETag *int32
+
+	if params.IfMatch != nil && strings.TrimSpace(*params.IfMatch) != "*" {
+		tag := strings.TrimSpace(*params.IfMatch)
+		if strings.HasPrefix(tag, "W/") {
+			return nil, errors.New("the If-Match header must be a strong entity tag")
+		}
+
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil {
+			return nil, errors.New("the If-Match header must be a quoted entity tag")
+		}
+
+		version, err := strconv.ParseInt(unquoted, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("the If-Match header does not match any version: %s", tag)
+		}
+		fromETag = ptr.To(int32(version))
+	}
+
+	if fromETag != nil && params.XExpectedVersion != nil && *fromETag != *params.XExpectedVersion {
+		return nil, errors.New("the If-Match and X-Expected-Version headers must not disagree")
+	}
+
+	if fromETag != nil {
+		return fromETag, nil
+	}
+	return params.XExpectedVersion, nil
+}
+
+func toAPIImportReport(report *service.ImportReport) ImportReport {
+	rows := make([]ImportRow, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = ImportRow{
+			Line:   row.Line,
+			Status: ImportRowStatus(row.Status),
+		}
+		if len(row.Errors) > 0 {
+			rows[i].Errors = &row.Errors
+		}
+	}
+
+	return ImportReport{
+		Mode:     ImportReportMode(report.Mode),
+		Imported: report.Imported,
+		Accepted: report.Accepted,
+		Rejected: report.Rejected,
+		Rows:     rows,
+	}
+}
+
+// toAPIMovieRevision converts a service Revision to an API MovieRevision
+func toAPIMovieRevision(revision *service.Revision) MovieRevision {
+	return MovieRevision{
+		Version:       revision.Movie.Version,
+		Operation:     MovieRevisionOperation(revision.Operation),
+		Movie:         toAPIMovie(revision.Movie),
+		ChangedFields: revision.ChangedFields,
+		UserId:        revision.UserID,
+		TraceId:       revision.TraceID,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
+
+// toAPIGenre converts a service Genre to an API Genre
+func toAPIGenre(genre *service.Genre) Genre {
+	return Genre{
+		Slug:    genre.Slug,
+		Name:    genre.Name,
+		Aliases: genre.Aliases,
+	}
+}
+
+// toAPIPerson converts a service Person to an API Person
+func toAPIPerson(person *service.Person) Person {
+	return Person{
+		Id:        person.ID,
+		Name:      person.Name,
+		BirthYear: person.BirthYear,
+	}
+}
+
+// toAPICredit converts a service Credit to an API Credit
+func toAPICredit(credit *service.Credit) Credit {
+	apiCredit := Credit{
+		Id:           credit.ID,
+		PersonId:     credit.PersonID,
+		PersonName:   credit.PersonName,
+		Role:         CreditRole(credit.Role),
+		BillingOrder: credit.BillingOrder,
+	}
+	if credit.Character != "" {
+		apiCredit.Character = ptr.To(credit.Character)
+	}
+
+	return apiCredit
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zbsss/greenlight/pkg/srvx"
+)
+
+func (s Server) PostV1Users(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput RegisterUserRequest) (srvx.Response[srvx.Envelope], error) {
+		user, err := s.us.RegisterUser(ctx, apiInput.toService())
+		if err != nil {
+			return srvx.Response[srvx.Envelope]{}, err
+		}
+
+		srvx.Logger(ctx).Info("registered user", "id", user.ID)
+
+		return srvx.Response[srvx.Envelope]{
+			Status: http.StatusAccepted,
+			Body:   srvx.Envelope{"user": toAPIUser(user)},
+		}, nil
+	})(w, r)
+}
+
+func (s Server) PutV1UsersActivated(w http.ResponseWriter, r *http.Request) {
+	srvx.JSON(s.errs, func(ctx context.Context, apiInput ActivateUserRequest) (srvx.Envelope, error) {
+		user, err := s.us.ActivateUser(ctx, apiInput.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		srvx.Logger(ctx).Info("activated user", "id", user.ID)
+
+		return srvx.Envelope{"user": toAPIUser(user)}, nil
+	})(w, r)
+}
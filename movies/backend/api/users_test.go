@@ -0,0 +1,53 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+func TestRegisterAndActivateUser(t *testing.T) {
+	db := mocks.NewMockQueries()
+	notifier := &mocks.Notifier[*service.User]{}
+	us := service.NewUserService(db, notifier)
+	movieServer := NewServer(service.New(db), us)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	register := `{"name": "Alice Smith", "email": "alice@example.com", "password": "pa55word"}`
+
+	code, _, body := ts.Do(t, http.MethodPost, "/v1/users", strings.NewReader(register))
+	if code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, code, body)
+	}
+	if !strings.Contains(body, `"activated": false`) {
+		t.Errorf("expected user to not be activated, got %s", body)
+	}
+
+	code, _, body = ts.Do(t, http.MethodPost, "/v1/users", strings.NewReader(register))
+	if code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected status %d for duplicate email, got %d: %s", http.StatusUnprocessableEntity, code, body)
+	}
+
+	activate := fmt.Sprintf(`{"token": %q}`, notifier.Token)
+
+	code, _, body = ts.Do(t, http.MethodPut, "/v1/users/activated", strings.NewReader(activate))
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, code, body)
+	}
+	if !strings.Contains(body, `"activated": true`) {
+		t.Errorf("expected user to be activated, got %s", body)
+	}
+
+	code, _, body = ts.Do(t, http.MethodPut, "/v1/users/activated", strings.NewReader(activate))
+	if code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected status %d for a used token, got %d: %s", http.StatusUnprocessableEntity, code, body)
+	}
+}
@@ -0,0 +1,285 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+// maxRequestBodyBytes is the limit srvx.ReadJSON puts on request bodies. The
+// body is read before the handler sees it, so the limit is applied here too.
+const maxRequestBodyBytes = 1_048_576
+
+// pathParamRX matches the path parameters of a route, such as {id}.
+var pathParamRX = regexp.MustCompile(`\{(\w+)\}`)
+
+// TestingT is the part of testing.TB used to report invalid responses.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// SpecValidator validates requests against the embedded OpenAPI spec before
+// they reach the Server.
+type SpecValidator struct {
+	spec    *openapi3.T
+	options *openapi3filter.Options
+	t       TestingT
+}
+
+type SpecValidatorOption func(*SpecValidator)
+
+// WithResponseValidation also validates every response against the spec and
+// fails the test when it doesn't match, so that the spec and the handlers
+// can't drift apart. Only meant for tests, responses are copied in memory.
+func WithResponseValidation(t TestingT) SpecValidatorOption {
+	return func(v *SpecValidator) {
+		v.t = t
+	}
+}
+
+func NewSpecValidator(opts ...SpecValidatorOption) (*SpecValidator, error) {
+	spec, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("loading OpenAPI spec: %w", err)
+	}
+
+	v := &SpecValidator{
+		spec: spec,
+		options: &openapi3filter.Options{
+			MultiError:          true,
+			SkipSettingDefaults: true,
+			// Authentication and authorization are left to srvx and
+			// Authorize.
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v, nil
+}
+
+// Middleware validates the parameters, content type and body of requests.
+// Use it in StdHTTPServerOptions.Middlewares, it finds the operation from
+// the pattern of the route the request was matched to.
+func (v *SpecValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, ok := v.route(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    v.options,
+		}
+
+		streamed := isStreamed(route.Operation)
+		if streamed {
+			// Streamed bodies, such as imports, are read and limited by
+			// the handler, only their content type is checked here.
+			options := *v.options
+			options.ExcludeRequestBody = true
+			input.Options = &options
+		}
+
+		if v.t != nil {
+			capture := &responseCapture{ResponseWriter: w, status: http.StatusOK}
+			defer v.validateResponse(input, capture)
+			w = capture
+		}
+
+		if streamed {
+			if !acceptsContentType(route.Operation, r.Header.Get("Content-Type")) {
+				srvx.WriteProblem(w, r, srvx.NewProblem(http.StatusUnsupportedMediaType, srvx.CodeUnsupportedMediaType,
+					fmt.Sprintf("header Content-Type has unexpected value %q", r.Header.Get("Content-Type"))))
+				return
+			}
+		} else {
+			r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+		}
+
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			writeValidationError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// route looks up the operation of the route pattern, such as
+// "GET /v1/movies/{id}", which the ServeMux set on the request.
+func (v *SpecValidator) route(r *http.Request) (*routers.Route, map[string]string, bool) {
+	_, path, ok := strings.Cut(r.Pattern, " ")
+	if !ok {
+		return nil, nil, false
+	}
+
+	pathItem := v.spec.Paths.Value(path)
+	if pathItem == nil {
+		return nil, nil, false
+	}
+
+	operation := pathItem.GetOperation(r.Method)
+	if operation == nil {
+		return nil, nil, false
+	}
+
+	pathParams := map[string]string{}
+	for _, m := range pathParamRX.FindAllStringSubmatch(path, -1) {
+		pathParams[m[1]] = r.PathValue(m[1])
+	}
+
+	return &routers.Route{
+		Spec:      v.spec,
+		Path:      path,
+		PathItem:  pathItem,
+		Method:    r.Method,
+		Operation: operation,
+	}, pathParams, true
+}
+
+// isStreamed reports whether the body of the operation is read as a stream
+// by the handler, which is the case for bodies that are not JSON.
+func isStreamed(operation *openapi3.Operation) bool {
+	if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return false
+	}
+	return operation.RequestBody.Value.GetMediaType("application/json") == nil
+}
+
+func acceptsContentType(operation *openapi3.Operation, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return operation.RequestBody.Value.GetMediaType(mediaType) != nil
+}
+
+func (v *SpecValidator) validateResponse(input *openapi3filter.RequestValidationInput, capture *responseCapture) {
+	v.t.Helper()
+
+	// Streamed formats, such as NDJSON, have no decoder, only their status
+	// and headers are checked.
+	mediaType, _, _ := mime.ParseMediaType(capture.Header().Get("Content-Type"))
+
+	err := openapi3filter.ValidateResponse(input.Request.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 capture.status,
+		Header:                 capture.Header(),
+		Body:                   io.NopCloser(&capture.body),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			ExcludeResponseBody:   openapi3filter.RegisteredBodyDecoder(mediaType) == nil,
+		},
+	})
+	if err != nil {
+		v.t.Errorf("response to %s %s does not match the OpenAPI spec: %v", input.Request.Method, input.Request.URL, err)
+	}
+}
+
+// writeValidationError reports the schema violations of parameters and the
+// body as field errors, the rest of the errors are malformed requests.
+func writeValidationError(w http.ResponseWriter, r *http.Request, err error) {
+	v := validator.New()
+	var malformed []string
+
+	for _, err := range flattenErrors(err) {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(err, &requestErr) {
+			malformed = append(malformed, err.Error())
+			continue
+		}
+
+		if requestErr.RequestBody != nil && strings.HasPrefix(requestErr.Reason, "header Content-Type") {
+			srvx.WriteProblem(w, r, srvx.NewProblem(http.StatusUnsupportedMediaType, srvx.CodeUnsupportedMediaType, requestErr.Reason))
+			return
+		}
+
+		schemaErrs := schemaErrors(requestErr.Err)
+		if len(schemaErrs) == 0 {
+			malformed = append(malformed, requestErr.Error())
+			continue
+		}
+
+		for _, schemaErr := range schemaErrs {
+			field := strings.Join(schemaErr.JSONPointer(), ".")
+			if requestErr.Parameter != nil {
+				field = requestErr.Parameter.Name
+			}
+			v.AddError(field, schemaErr.Reason)
+		}
+	}
+
+	if len(malformed) > 0 {
+		srvx.ErrBadRequest(w, r, errors.New(strings.Join(malformed, "; ")))
+		return
+	}
+
+	srvx.ErrBadRequest(w, r, v.OK())
+}
+
+// flattenErrors returns the errors collected in (nested) MultiErrors.
+func flattenErrors(err error) []error {
+	// Not errors.As, which would look through the RequestErrors too.
+	me, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, err := range me {
+		errs = append(errs, flattenErrors(err)...)
+	}
+	return errs
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	var schemaErrs []*openapi3.SchemaError
+	for _, err := range flattenErrors(err) {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) {
+			schemaErrs = append(schemaErrs, schemaErr)
+		}
+	}
+	return schemaErrs
+}
+
+// responseCapture keeps a copy of the response for validating it.
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseCapture) WriteHeader(statusCode int) {
+	w.status = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseCapture) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseCapture) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+// newTestHandler routes requests to si without authorizing them, validating
+// the requests and failing the test when a response doesn't match the spec.
+// apitest serves the whole API the way main does.
+func newTestHandler(t *testing.T, si ServerInterface) http.Handler {
+	t.Helper()
+
+	v, err := NewSpecValidator(WithResponseValidation(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter:       http.NewServeMux(),
+		Middlewares:      []MiddlewareFunc{v.Middleware},
+		ErrorHandlerFunc: srvx.ErrBadRequest,
+	})
+}
+
+func TestSpecValidator(t *testing.T) {
+	db := mocks.NewMockQueries()
+	movieServer := NewServer(service.New(db), nil)
+	h := newTestHandler(t, movieServer)
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	tcs := []struct {
+		name           string
+		method         string
+		url            string
+		contentType    string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expectedErrors []string
+	}{
+		{
+			name:           "valid body",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			contentType:    "application/json",
+			body:           `{"title": "Moana", "year": 2016, "runtimeMin": 107, "genres": ["animation"]}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "body violates schema",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			contentType:    "application/json",
+			body:           `{"title": "", "year": 2016, "runtimeMin": -1, "genres": ["animation", "animation"]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedErrors: []string{"title", "runtimeMin", "genres"},
+		},
+		{
+			name:           "missing body",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			contentType:    "application/json",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   srvx.CodeBadRequest,
+		},
+		{
+			name:           "unsupported content type",
+			method:         http.MethodPost,
+			url:            "/v1/movies",
+			contentType:    "text/plain",
+			body:           `{"title": "Moana", "year": 2016, "runtimeMin": 107, "genres": ["animation"]}`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedCode:   srvx.CodeUnsupportedMediaType,
+		},
+		{
+			name:           "query parameter violates schema",
+			method:         http.MethodGet,
+			url:            "/v1/movies?page_size=1000",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   srvx.CodeValidationFailed,
+			expectedErrors: []string{"page_size"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			db.Reset(mocks.TestMovie1)
+
+			req, err := http.NewRequestWithContext(t.Context(), tc.method, ts.URL+tc.url, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			rs, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rs.Body.Close()
+
+			if rs.StatusCode != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rs.StatusCode)
+			}
+			if tc.expectedCode == "" {
+				return
+			}
+
+			var p srvx.Problem
+			if err := json.NewDecoder(rs.Body).Decode(&p); err != nil {
+				t.Fatal(err)
+			}
+			if p.Code != tc.expectedCode {
+				t.Errorf("expected code %q, got %q: %s", tc.expectedCode, p.Code, p.Detail)
+			}
+			for _, field := range tc.expectedErrors {
+				if _, ok := p.Errors[field]; !ok {
+					t.Errorf("expected an error for %q, got %v", field, p.Errors)
+				}
+			}
+		})
+	}
+}
+
+// driftingServer returns a movie which is missing required fields.
+type driftingServer struct {
+	ServerInterface
+}
+
+func (driftingServer) GetV1MoviesTrash(w http.ResponseWriter, _ *http.Request) {
+	_ = srvx.WriteJSON(w, http.StatusOK, srvx.Envelope{"movies": []srvx.Envelope{{"title": "Moana"}}}, nil)
+}
+
+type recordingT struct {
+	errors []string
+}
+
+func (*recordingT) Helper() {}
+
+func (rt *recordingT) Errorf(format string, args ...any) {
+	rt.errors = append(rt.errors, fmt.Sprintf(format, args...))
+}
+
+func TestSpecValidatorResponses(t *testing.T) {
+	rt := &recordingT{}
+	v, err := NewSpecValidator(WithResponseValidation(rt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := HandlerWithOptions(driftingServer{}, StdHTTPServerOptions{
+		Middlewares: []MiddlewareFunc{v.Middleware},
+	})
+
+	ts := testserver.New(h)
+	defer ts.Close()
+
+	code, _, _ := ts.Get(t, "/v1/movies/trash")
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	if len(rt.errors) != 1 || !strings.Contains(rt.errors[0], "does not match the OpenAPI spec") {
+		t.Fatalf("expected the response to be reported, got %q", rt.errors)
+	}
+}
@@ -0,0 +1,95 @@
+// Package mailer delivers the tokens of the user service by email.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/zbsss/greenlight/movies/backend/service"
+)
+
+// Config is the SMTP server the emails are sent through. Username and
+// Password are optional, the server is used without authentication when
+// Username is empty.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// Sender is the From address, e.g. "Greenlight <no-reply@example.com>".
+	Sender string
+}
+
+// Mailer sends the activation tokens of new users by email. It implements
+// service.Notifier.
+type Mailer struct {
+	addr   string
+	auth   smtp.Auth
+	sender *mail.Address
+}
+
+var _ service.Notifier = &Mailer{}
+
+// New returns a Mailer for the SMTP server. It returns an error when the
+// configuration is incomplete.
+func New(cfg Config) (*Mailer, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("mailer: host must be set")
+	}
+	if cfg.Port <= 0 {
+		return nil, errors.New("mailer: port must be greater than zero")
+	}
+	sender, err := mail.ParseAddress(cfg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("mailer: invalid sender: %w", err)
+	}
+
+	m := &Mailer{
+		addr:   net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		sender: sender,
+	}
+	if cfg.Username != "" {
+		m.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return m, nil
+}
+
+// SendActivationToken emails the token to the user. smtp.SendMail can not be
+// cancelled, ctx is only checked before the email is sent.
+func (m *Mailer) SendActivationToken(ctx context.Context, user *service.User, token string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	to := &mail.Address{Name: user.Name, Address: user.Email}
+	msg := activationMessage(m.sender, to, token, time.Now())
+
+	if err := smtp.SendMail(m.addr, m.auth, m.sender.Address, []string{to.Address}, msg); err != nil {
+		return fmt.Errorf("mailer: sending activation token: %w", err)
+	}
+	return nil
+}
+
+func activationMessage(from, to *mail.Address, token string, now time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: Activate your Greenlight account\r\n")
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&b, "\r\n")
+	fmt.Fprintf(&b, "Hi %s,\r\n\r\n", to.Name)
+	fmt.Fprintf(&b, "Thanks for signing up for a Greenlight account. To activate it, send a\r\n")
+	fmt.Fprintf(&b, "PUT /v1/users/activated request with the body:\r\n\r\n")
+	fmt.Fprintf(&b, "{\"token\": %q}\r\n\r\n", token)
+	fmt.Fprintf(&b, "The token can only be used once and expires in 3 days.\r\n")
+	return b.Bytes()
+}
@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"bytes"
+	"io"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "valid", cfg: Config{Host: "smtp.example.com", Port: 587, Sender: "Greenlight <no-reply@example.com>"}},
+		{name: "missing host", cfg: Config{Port: 587, Sender: "no-reply@example.com"}, wantErr: true},
+		{name: "missing port", cfg: Config{Host: "smtp.example.com", Sender: "no-reply@example.com"}, wantErr: true},
+		{name: "invalid sender", cfg: Config{Host: "smtp.example.com", Port: 587, Sender: "greenlight"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestActivationMessage(t *testing.T) {
+	from := &mail.Address{Name: "Greenlight", Address: "no-reply@example.com"}
+	to := &mail.Address{Name: "Alice\r\nBcc: eve@example.com", Address: "alice@example.com"}
+
+	raw := activationMessage(from, to, "Y3QMGX3PJ3WLRL2YRTQGQ6KRHU", time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The name of the user must not be able to add headers.
+	if bcc := msg.Header.Get("Bcc"); bcc != "" {
+		t.Errorf("expected no Bcc header, got %q", bcc)
+	}
+	recipients, err := msg.Header.AddressList("To")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recipients) != 1 || recipients[0].Address != "alice@example.com" {
+		t.Errorf("expected the email to be sent to alice@example.com, got %v", recipients)
+	}
+
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `{"token": "Y3QMGX3PJ3WLRL2YRTQGQ6KRHU"}`) {
+		t.Errorf("expected the body to contain the token, got:\n%s", body)
+	}
+}
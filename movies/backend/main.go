@@ -1,45 +1,172 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"log/slog"
-	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/zbsss/greenlight/movies/backend/api"
+	"github.com/zbsss/greenlight/movies/backend/mailer"
 	"github.com/zbsss/greenlight/movies/backend/service"
 	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/movies/backend/storage/migrations"
 	"github.com/zbsss/greenlight/movies/backend/storage/teststorage"
 
 	"github.com/zbsss/greenlight/pkg/srvx"
 )
 
-const defaultPort = 400
+const (
+	defaultPort           = 400
+	defaultAdminPort      = 401
+	defaultTrashRetention = 30 * 24 * time.Hour
+	trashPurgeInterval    = time.Hour
+	defaultCORSOrigin     = "http://localhost:3000"
+	corsMaxAge            = time.Hour
+	defaultSMTPPort       = 587
+
+	defaultDBMaxConns          = 25
+	defaultDBMinConns          = 2
+	defaultDBMaxConnLifetime   = time.Hour
+	defaultDBMaxConnIdleTime   = 15 * time.Minute
+	defaultDBHealthCheckPeriod = time.Minute
+)
 
 type config struct {
-	port int
-	env  string
-	db   struct {
-		dsn string
+	port      int
+	adminPort int
+	env       string
+	db        struct {
+		dsn               string
+		maxConns          int
+		minConns          int
+		maxConnLifetime   time.Duration
+		maxConnIdleTime   time.Duration
+		healthCheckPeriod time.Duration
+		statementTimeout  time.Duration
+	}
+	trash struct {
+		retention time.Duration
+	}
+	cursorSecret string
+	limiter      struct {
+		enabled bool
+		rps     float64
+		burst   int
+	}
+	cors struct {
+		trustedOrigins   []string
+		allowCredentials bool
+	}
+	trace struct {
+		exporter string
+		file     string
+	}
+	smtp struct {
+		host     string
+		port     int
+		username string
+		password string
+		sender   string
 	}
 }
 
 func mainNoExit() error {
 	var cfg config
 	flag.IntVar(&cfg.port, "port", defaultPort, "Port")
+	flag.IntVar(&cfg.adminPort, "admin-port", defaultAdminPort, "Port for operational endpoints such as /metrics")
 	flag.StringVar(&cfg.env, "env", "dev", "Environment (dev|prod)")
-	flag.StringVar(&cfg.db.dsn, "db-dsn", "", "PostgresSQL DSN")
+	flag.StringVar(&cfg.db.dsn, "db-dsn", "", "PostgresSQL DSN (env DB_DSN)")
+	flag.IntVar(&cfg.db.maxConns, "db-max-conns", defaultDBMaxConns, "PostgreSQL maximum open connections (env DB_MAX_CONNS)")
+	flag.IntVar(&cfg.db.minConns, "db-min-conns", defaultDBMinConns, "PostgreSQL minimum open connections (env DB_MIN_CONNS)")
+	flag.DurationVar(&cfg.db.maxConnLifetime, "db-max-conn-lifetime", defaultDBMaxConnLifetime, "PostgreSQL maximum connection lifetime (env DB_MAX_CONN_LIFETIME)")
+	flag.DurationVar(&cfg.db.maxConnIdleTime, "db-max-conn-idle-time", defaultDBMaxConnIdleTime, "PostgreSQL maximum connection idle time (env DB_MAX_CONN_IDLE_TIME)")
+	flag.DurationVar(&cfg.db.healthCheckPeriod, "db-health-check-period", defaultDBHealthCheckPeriod, "How often idle PostgreSQL connections are checked (env DB_HEALTH_CHECK_PERIOD)")
+	flag.DurationVar(&cfg.db.statementTimeout, "db-statement-timeout", 0, "PostgreSQL statement timeout, 0 uses the server default (env DB_STATEMENT_TIMEOUT)")
+	flag.DurationVar(&cfg.trash.retention, "trash-retention", defaultTrashRetention, "How long deleted movies are kept before being purged")
+	flag.StringVar(&cfg.cursorSecret, "cursor-secret", os.Getenv("CURSOR_SECRET"), "Key used to sign pagination cursors, required outside of dev (env CURSOR_SECRET)")
+	flag.BoolVar(&cfg.limiter.enabled, "limiter-enabled", true, "Enable rate limiting")
+	flag.Float64Var(&cfg.limiter.rps, "limiter-rps", 2, "Rate limiter maximum requests per second per client")
+	flag.IntVar(&cfg.limiter.burst, "limiter-burst", 4, "Rate limiter maximum burst per client")
+	flag.Func("cors-trusted-origins", "Trusted CORS origins, space separated, e.g. \"https://*.example.com\" (default \"http://localhost:3000\")", func(val string) error {
+		cfg.cors.trustedOrigins = strings.Fields(val)
+		return nil
+	})
+	flag.BoolVar(&cfg.cors.allowCredentials, "cors-allow-credentials", false, "Allow CORS requests with credentials")
+	flag.StringVar(&cfg.trace.exporter, "trace-exporter", cmp.Or(os.Getenv("OTEL_TRACES_EXPORTER"), "none"), "Where to export traces (otlp|stdout|none)")
+	flag.StringVar(&cfg.trace.file, "trace-file", "", "File the stdout trace exporter writes to instead of stdout")
+	flag.StringVar(&cfg.smtp.host, "smtp-host", "", "SMTP server activation tokens are emailed through, required outside of dev (env SMTP_HOST)")
+	flag.IntVar(&cfg.smtp.port, "smtp-port", defaultSMTPPort, "SMTP server port (env SMTP_PORT)")
+	flag.StringVar(&cfg.smtp.username, "smtp-username", "", "SMTP username (env SMTP_USERNAME)")
+	flag.StringVar(&cfg.smtp.password, "smtp-password", "", "SMTP password (env SMTP_PASSWORD)")
+	flag.StringVar(&cfg.smtp.sender, "smtp-sender", "Greenlight <no-reply@greenlight.local>", "From address of the emails (env SMTP_SENDER)")
 	flag.Parse()
 
+	err := flagsFromEnv(map[string]string{
+		"db-dsn":                 "DB_DSN",
+		"db-max-conns":           "DB_MAX_CONNS",
+		"db-min-conns":           "DB_MIN_CONNS",
+		"db-max-conn-lifetime":   "DB_MAX_CONN_LIFETIME",
+		"db-max-conn-idle-time":  "DB_MAX_CONN_IDLE_TIME",
+		"db-health-check-period": "DB_HEALTH_CHECK_PERIOD",
+		"db-statement-timeout":   "DB_STATEMENT_TIMEOUT",
+		"smtp-host":              "SMTP_HOST",
+		"smtp-port":              "SMTP_PORT",
+		"smtp-username":          "SMTP_USERNAME",
+		"smtp-password":          "SMTP_PASSWORD",
+		"smtp-sender":            "SMTP_SENDER",
+	})
+	if err != nil {
+		return err
+	}
+
+	// Every replica must sign cursors with the same key, and keep it across
+	// deploys, for cursors to be accepted by the next request.
+	if cfg.cursorSecret == "" && cfg.env != "dev" {
+		return errors.New("cursor-secret must be set outside of dev")
+	}
+
+	if cfg.cors.trustedOrigins == nil {
+		cfg.cors.trustedOrigins = []string{defaultCORSOrigin}
+	}
+
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracingCfg := srvx.TracingConfig{
+		ServiceName: "greenlight-movies",
+		Exporter:    cfg.trace.exporter,
+	}
+	if cfg.trace.file != "" {
+		f, err := os.Create(cfg.trace.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		tracingCfg.Output = f
+	}
 
-	movieStorage, cleanup, err := setupStorage(ctx, cfg.env, cfg.db.dsn)
+	tp, err := srvx.NewTracerProvider(ctx, tracingCfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tp.Shutdown(context.Background()); err != nil {
+			logger.Error("failed to flush traces", "error", err)
+		}
+	}()
+
+	movieStorage, db, cleanup, err := setupStorage(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -49,32 +176,198 @@ func mainNoExit() error {
 		}
 	}()
 
-	ms := service.New(movieStorage)
-	moviesServer := api.NewServer(ms)
+	health := srvx.NewHealth()
+	health.AddReadinessCheck("postgres", db.Ping)
+	health.AddReadinessCheck("migrations", func(ctx context.Context) error {
+		return migrations.Check(ctx, db)
+	})
+
+	metrics := srvx.NewMetrics()
+	metrics.Registry().MustRegister(storage.NewPoolCollector(db))
+
+	var serviceOpts []service.Option
+	if cfg.cursorSecret != "" {
+		serviceOpts = append(serviceOpts, service.WithCursorSecret([]byte(cfg.cursorSecret)))
+	}
+
+	ms := service.New(movieStorage, serviceOpts...)
+	go purgeTrash(ctx, ms, cfg.trash.retention, logger)
 
-	router := http.NewServeMux()
-	h := api.HandlerFromMux(moviesServer, router)
-	srv := srvx.NewServer(srvx.Config{Port: cfg.port}, h, logger)
+	notifier, err := newNotifier(cfg, logger)
+	if err != nil {
+		return err
+	}
+	us := service.NewUserService(movieStorage, notifier)
+
+	h, err := api.NewHandler(ms, us)
+	if err != nil {
+		return err
+	}
+
+	srv, err := srvx.NewServer(srvx.Config{
+		Port:           cfg.port,
+		AdminPort:      cfg.adminPort,
+		Metrics:        metrics,
+		Health:         health,
+		TracerProvider: tp,
+		Authenticator:  api.NewAuthenticator(us),
+		RateLimit: srvx.RateLimitConfig{
+			Enabled: cfg.limiter.enabled,
+			RPS:     cfg.limiter.rps,
+			Burst:   cfg.limiter.burst,
+		},
+		CORS: srvx.CORSConfig{
+			AllowedOrigins: cfg.cors.trustedOrigins,
+			AllowedHeaders: []string{"Authorization", "Content-Type", "If-Match", "X-Expected-Version", "X-Trace-ID"},
+			ExposedHeaders: []string{
+				"ETag", "Location", "X-Trace-ID",
+				"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After",
+			},
+			MaxAge:           corsMaxAge,
+			AllowCredentials: cfg.cors.allowCredentials,
+		},
+	}, h, logger)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("starting server", "addr", srv.Addr, "env", cfg.env)
 	return srv.ListenAndServe(ctx)
 }
 
-func setupStorage(ctx context.Context, env, dsn string) (storage.Querier, func(context.Context) error, error) {
-	if env == "dev" && dsn == "" {
+// database is the connection pool behind the storage, used for health
+// checks and metrics.
+type database interface {
+	migrations.DB
+	storage.Statter
+	Ping(ctx context.Context) error
+}
+
+func setupStorage(ctx context.Context, cfg config) (storage.Store, database, func(context.Context) error, error) {
+	if cfg.env == "dev" && cfg.db.dsn == "" {
 		ts, err := teststorage.New(ctx)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
+		}
+		return ts, ts.DB(), ts.Close, nil
+	} else if cfg.env == "prod" {
+		pool, err := openPool(ctx, cfg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		closePool := func(context.Context) error {
+			pool.Close()
+			return nil
+		}
+		return storage.NewStore(pool), pool, closePool, nil
+	}
+	return nil, nil, nil, fmt.Errorf("unsupported environment: %s", cfg.env)
+}
+
+func openPool(ctx context.Context, cfg config) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(cfg.db.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	poolConfig.MaxConns = int32(cfg.db.maxConns)
+	poolConfig.MinConns = int32(cfg.db.minConns)
+	poolConfig.MaxConnLifetime = cfg.db.maxConnLifetime
+	poolConfig.MaxConnIdleTime = cfg.db.maxConnIdleTime
+	poolConfig.HealthCheckPeriod = cfg.db.healthCheckPeriod
+	poolConfig.ConnConfig.Tracer = storage.NewQueryTracer()
+	if cfg.db.statementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.db.statementTimeout.Milliseconds(), 10)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pool connects lazily, fail at startup rather than on the first
+	// request when the database is unreachable.
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// flagsFromEnv sets the flags which were not given on the command line from
+// their environment variables.
+func flagsFromEnv(envs map[string]string) error {
+	given := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		given[f.Name] = true
+	})
+
+	for name, env := range envs {
+		val, ok := os.LookupEnv(env)
+		if !ok || given[name] {
+			continue
 		}
-		return ts, ts.Close, nil
-	} else if env == "prod" {
-		conn, err := pgx.Connect(ctx, dsn)
+		if err := flag.Set(name, val); err != nil {
+			return fmt.Errorf("invalid %s: %w", env, err)
+		}
+	}
+
+	return nil
+}
+
+// purgeTrash periodically hard-deletes movies which have been in the trash for
+// longer than the retention period, until ctx is cancelled.
+func purgeTrash(ctx context.Context, ms *service.MovieService, retention time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := ms.PurgeDeletedMovies(ctx, retention)
 		if err != nil {
-			return nil, nil, err
+			logger.Error("failed to purge trash", "error", err)
+		} else if purged > 0 {
+			logger.Info("purged trash", "movies", purged, "retention", retention.String())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
-		return storage.New(conn), conn.Close, nil
 	}
-	return nil, nil, fmt.Errorf("unsupported environment: %s", env)
+}
+
+// newNotifier emails the activation tokens when an SMTP server is
+// configured. Without one the tokens are logged instead, which is only
+// allowed in development: nobody could activate their account otherwise, and
+// tokens must not end up in the logs of other environments.
+func newNotifier(cfg config, logger *slog.Logger) (service.Notifier, error) {
+	if cfg.smtp.host != "" {
+		return mailer.New(mailer.Config{
+			Host:     cfg.smtp.host,
+			Port:     cfg.smtp.port,
+			Username: cfg.smtp.username,
+			Password: cfg.smtp.password,
+			Sender:   cfg.smtp.sender,
+		})
+	}
+	if cfg.env != "dev" {
+		return nil, errors.New("smtp-host must be set outside of dev, activation tokens could not be delivered otherwise")
+	}
+
+	return logNotifier{logger: logger}, nil
+}
+
+// logNotifier stands in for a mailer in development by logging the
+// activation tokens.
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+func (n logNotifier) SendActivationToken(ctx context.Context, user *service.User, token string) error {
+	n.logger.InfoContext(ctx, "sending activation token", "user_id", user.ID, "email", user.Email, "token", token)
+	return nil
 }
 
 func main() {
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestUpdateMovieConcurrentEdits(t *testing.T) {
+	ctx := context.Background()
+	s := New(setupTestStorage(t))
+
+	movie, err := s.GetMovie(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const editors = 10
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		updated   int
+		conflicts int
+	)
+	for i := range editors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := s.UpdateMovie(ctx, movie.ID, PartialMovieUpdate{
+				Title:           ptr.To(fmt.Sprintf("%s (edit %d)", movie.Title, i)),
+				ExpectedVersion: ptr.To(movie.Version),
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				updated++
+			case errors.Is(err, ErrEditConflict):
+				conflicts++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if updated != 1 || conflicts != editors-1 {
+		t.Fatalf("expected 1 update and %d conflicts; got %d updates and %d conflicts", editors-1, updated, conflicts)
+	}
+
+	final, err := s.GetMovie(ctx, movie.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if final.Version != movie.Version+1 {
+		t.Fatalf("expected version %d; got %d", movie.Version+1, final.Version)
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const cursorMaxAge = 24 * time.Hour
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// movieCursor is the position of the last movie on a keyset page. It is
+// handed to clients as an opaque, signed token.
+type movieCursor struct {
+	Sort     string `json:"s"`
+	Filters  string `json:"f"`
+	ID       int64  `json:"id"`
+	Title    string `json:"t,omitempty"`
+	Year     int32  `json:"y,omitempty"`
+	Runtime  int32  `json:"r,omitempty"`
+	IssuedAt int64  `json:"iat"`
+}
+
+func newMovieCursor(m *Movie, filters *MovieFilters) movieCursor {
+	c := movieCursor{
+		Sort:     filters.Sort,
+		Filters:  filters.fingerprint(),
+		ID:       m.ID,
+		IssuedAt: time.Now().Unix(),
+	}
+
+	// Only the key used for sorting is needed to resume the listing.
+	switch strings.TrimPrefix(filters.Sort, "-") {
+	case "title":
+		c.Title = m.Title
+	case "year":
+		c.Year = m.Year
+	case "runtime":
+		c.Runtime = m.RuntimeMin
+	}
+
+	return c
+}
+
+// cursorCodec signs and verifies cursors so that clients cannot forge a
+// position in the listing.
+type cursorCodec struct {
+	secret []byte
+}
+
+func (cc cursorCodec) encode(c movieCursor) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(cc.sign(payload)), nil
+}
+
+// decode verifies the cursor signature and checks that it was issued
+// recently for the same sort order and filters.
+func (cc cursorCodec) decode(token string, filters *MovieFilters) (movieCursor, error) {
+	var c movieCursor
+
+	encPayload, encSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return c, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+
+	enc := base64.RawURLEncoding
+	payload, err := enc.DecodeString(encPayload)
+	if err != nil {
+		return c, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+	sig, err := enc.DecodeString(encSig)
+	if err != nil {
+		return c, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+
+	if !hmac.Equal(sig, cc.sign(payload)) {
+		return c, fmt.Errorf("%w: signature mismatch", ErrInvalidCursor)
+	}
+
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return c, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+
+	switch {
+	case time.Since(time.Unix(c.IssuedAt, 0)) > cursorMaxAge:
+		return c, fmt.Errorf("%w: cursor has expired", ErrInvalidCursor)
+	case c.Sort != filters.Sort:
+		return c, fmt.Errorf("%w: cursor was issued for sort %q", ErrInvalidCursor, c.Sort)
+	case c.Filters != filters.fingerprint():
+		return c, fmt.Errorf("%w: cursor was issued for different filters", ErrInvalidCursor)
+	}
+
+	return c, nil
+}
+
+func (cc cursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, cc.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// fingerprint identifies the filters a cursor was issued for, so that it
+// cannot be replayed against a different result set.
+func (f MovieFilters) fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q|%q|%d|%d|%d|%d|%d", f.Title, f.Genres, f.YearMin, f.YearMax, f.RuntimeMin, f.RuntimeMax, f.PersonID)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
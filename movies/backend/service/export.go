@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"iter"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+// ExportMovies returns all the movies matching the filters, in the order of
+// filters.Sort, as they are read from the database. The paging fields of the
+// filters are ignored. The filters are validated, and their genres resolved,
+// before any movie is read.
+func (s *MovieService) ExportMovies(ctx context.Context, filters MovieFilters) (iter.Seq2[*Movie, error], error) {
+	filters.Page, filters.PageSize = 0, 0
+	filters = filters.WithDefaults()
+	if err := filters.OK(); err != nil {
+		return nil, err
+	}
+	filters, err := s.canonicalFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	movies := s.storage.StreamMovies(ctx, storage.ExportMoviesParams{
+		Title:      likeEscaper.Replace(filters.Title),
+		Genres:     filters.Genres,
+		YearMin:    filters.YearMin,
+		YearMax:    filters.YearMax,
+		RuntimeMin: filters.RuntimeMin,
+		RuntimeMax: filters.RuntimeMax,
+		PersonID:   filters.PersonID,
+		Sort:       filters.Sort,
+	})
+
+	return func(yield func(*Movie, error) bool) {
+		for movie, err := range movies {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(transform(&movie), nil) {
+				return
+			}
+		}
+	}, nil
+}
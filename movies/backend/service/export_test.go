@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExportMoviesPostgres(t *testing.T) {
+	ctx := context.Background()
+	s := New(setupTestStorage(t))
+
+	filters := MovieFilters{Genres: []string{"drama"}, Sort: "-year"}
+
+	_, metadata, err := s.ListMovies(ctx, filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	movies, err := s.ExportMovies(ctx, filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var exported []*Movie
+	for movie, err := range movies {
+		if err != nil {
+			t.Fatal(err)
+		}
+		exported = append(exported, movie)
+	}
+
+	if int64(len(exported)) != metadata.TotalRecords {
+		t.Fatalf("expected %d movies; got %d", metadata.TotalRecords, len(exported))
+	}
+	for i := 1; i < len(exported); i++ {
+		if exported[i-1].Year < exported[i].Year {
+			t.Fatalf("expected movies sorted by descending year; got %d before %d", exported[i-1].Year, exported[i].Year)
+		}
+	}
+}
+
+func TestExportMoviesInvalidFilters(t *testing.T) {
+	h := setupTest(t)
+
+	_, err := h.service.ExportMovies(context.Background(), MovieFilters{Sort: "rating"})
+	if err == nil {
+		t.Fatal("expected an invalid sort to be rejected")
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"math"
+	"strings"
+
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+	DefaultSort     = "id"
+
+	pageMax     = 10_000_000
+	pageSizeMax = 100
+)
+
+// SortSafelist contains the values accepted by MovieFilters.Sort. A leading
+// "-" sorts in descending order.
+var SortSafelist = []string{"id", "title", "year", "runtime", "-id", "-title", "-year", "-runtime"}
+
+// likeEscaper escapes the characters ILIKE treats as wildcards, so that the
+// title filter matches them literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// MovieFilters narrows down, orders and paginates the movies returned by
+// ListMovies. Zero values mean "no filter". PersonID keeps the movies on
+// which the person is credited.
+type MovieFilters struct {
+	Title      string
+	Genres     []string
+	YearMin    int32
+	YearMax    int32
+	RuntimeMin int32
+	RuntimeMax int32
+	PersonID   int64
+	Sort       string
+	Page       int32
+	PageSize   int32
+}
+
+// Metadata describes the page of results returned by ListMovies and
+// ListMoviesKeyset. Keyset pages only set PageSize and NextCursor.
+type Metadata struct {
+	CurrentPage  int32
+	PageSize     int32
+	FirstPage    int32
+	LastPage     int32
+	TotalRecords int64
+	NextCursor   string
+}
+
+func (f MovieFilters) OK() error {
+	v := validator.New()
+
+	v.Check(f.Page > 0, "page", "must be greater than zero")
+	v.Check(f.Page <= pageMax, "page", "must be a maximum of 10 million")
+
+	v.Check(f.PageSize > 0, "page_size", "must be greater than zero")
+	v.Check(f.PageSize <= pageSizeMax, "page_size", "must be a maximum of 100")
+
+	v.Check(validator.PermittedValue(f.Sort, SortSafelist...), "sort", "invalid sort value")
+
+	v.Check(f.YearMin >= 0, "year_min", "must not be negative")
+	v.Check(f.YearMax >= 0, "year_max", "must not be negative")
+	v.Check(f.YearMax == 0 || f.YearMin <= f.YearMax, "year_max", "must not be less than year_min")
+
+	v.Check(f.RuntimeMin >= 0, "runtime_min", "must not be negative")
+	v.Check(f.RuntimeMax >= 0, "runtime_max", "must not be negative")
+	v.Check(f.RuntimeMax == 0 || f.RuntimeMin <= f.RuntimeMax, "runtime_max", "must not be less than runtime_min")
+
+	v.Check(validator.Unique(f.Genres), "genres", "must not contain duplicate values")
+
+	v.Check(f.PersonID >= 0, "person_id", "must not be negative")
+
+	return v.OK()
+}
+
+// WithDefaults returns a copy of the filters with the paging and sorting
+// fields set to their defaults when they were left empty.
+func (f MovieFilters) WithDefaults() MovieFilters {
+	if f.Page == 0 {
+		f.Page = DefaultPage
+	}
+	if f.PageSize == 0 {
+		f.PageSize = DefaultPageSize
+	}
+	if f.Sort == "" {
+		f.Sort = DefaultSort
+	}
+	return f
+}
+
+func (f MovieFilters) offset() int32 {
+	return (f.Page - 1) * f.PageSize
+}
+
+func calculateMetadata(totalRecords int64, page, pageSize int32) Metadata {
+	if totalRecords == 0 {
+		return Metadata{}
+	}
+
+	return Metadata{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		FirstPage:    1,
+		LastPage:     int32(math.Ceil(float64(totalRecords) / float64(pageSize))),
+		TotalRecords: totalRecords,
+	}
+}
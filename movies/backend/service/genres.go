@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+const (
+	genreSlugMaxLength   = 50
+	genreNameMaxLength   = 100
+	genreAliasesMaxCount = 20
+
+	// foreignKeyViolation is the Postgres error code returned for deleting
+	// rows which are still referenced.
+	foreignKeyViolation = "23503"
+)
+
+var (
+	ErrGenreNotFound = errors.New("genre not found")
+	ErrGenreInUse    = errors.New("genre in use")
+)
+
+var (
+	genreSlugRX = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// notSlugRX matches what genreSlug replaces with a dash, like the
+	// migration which created the genres.
+	notSlugRX = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Genre is an entry of the genre catalogue. Movies refer to genres by slug,
+// the aliases are other spellings which are mapped to the genre when movies
+// are written.
+type Genre struct {
+	Slug    string
+	Name    string
+	Aliases []string
+}
+
+type GenreInput struct {
+	Slug    string
+	Name    string
+	Aliases []string
+}
+
+type PartialGenreUpdate struct {
+	Name *string
+	// Aliases replaces all the aliases of the genre when not nil.
+	Aliases []string
+}
+
+func (g GenreInput) OK() error {
+	v := validator.New()
+
+	v.Check(g.Slug != "", "slug", errMustBeProvided)
+	v.Check(len(g.Slug) <= genreSlugMaxLength, "slug", "must not be more than 50 bytes long")
+	v.Check(validator.Matches(g.Slug, genreSlugRX), "slug", "must only contain lower case letters, digits and single dashes")
+
+	v.Check(g.Name != "", "name", errMustBeProvided)
+	v.Check(len(g.Name) <= genreNameMaxLength, "name", "must not be more than 100 bytes long")
+
+	v.Check(len(g.Aliases) <= genreAliasesMaxCount, "aliases", "must not contain more than 20 aliases")
+	v.Check(!slices.Contains(g.Aliases, ""), "aliases", "must contain letters or digits")
+	v.Check(validator.Unique(g.Aliases), "aliases", "must not contain duplicate values")
+	v.Check(!slices.Contains(g.Aliases, g.Slug), "aliases", "must not contain the slug of the genre")
+
+	return v.OK()
+}
+
+// genreSlug turns a spelling of a genre into a slug: lower case, with runs of
+// other characters than letters and digits replaced by a dash.
+func genreSlug(spelling string) string {
+	return strings.Trim(notSlugRX.ReplaceAllString(strings.ToLower(spelling), "-"), "-")
+}
+
+func genreSlugs(spellings []string) []string {
+	if spellings == nil {
+		return nil
+	}
+
+	slugs := make([]string, len(spellings))
+	for i, spelling := range spellings {
+		slugs[i] = genreSlug(spelling)
+	}
+	return slugs
+}
+
+func (s *MovieService) ListGenres(ctx context.Context) ([]*Genre, error) {
+	rows, err := s.storage.ListGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genres := make([]*Genre, len(rows))
+	for i, row := range rows {
+		genres[i] = transformGenre(&row.Genre, row.Aliases)
+	}
+	return genres, nil
+}
+
+func (s *MovieService) GetGenre(ctx context.Context, slug string) (*Genre, error) {
+	row, err := s.storage.GetGenre(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGenreNotFound
+		}
+
+		return nil, err
+	}
+
+	return transformGenre(&row.Genre, row.Aliases), nil
+}
+
+// CreateGenre adds a genre to the catalogue. The aliases are stored as slugs,
+// so "Science Fiction" is stored as science-fiction.
+func (s *MovieService) CreateGenre(ctx context.Context, input GenreInput) (*Genre, error) {
+	input.Aliases = genreSlugs(input.Aliases)
+	if err := input.OK(); err != nil {
+		return nil, err
+	}
+
+	var created storage.Genre
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		catalogue, err := loadGenres(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		v := validator.New()
+		if slug, ok := catalogue[input.Slug]; ok && slug != input.Slug {
+			v.AddError("slug", fmt.Sprintf("is an alias of %q", slug))
+		}
+		catalogue.checkAliases(v, "", input.Aliases)
+		if err := v.OK(); err != nil {
+			return err
+		}
+
+		created, err = q.CreateGenre(ctx, storage.CreateGenreParams{
+			Slug: input.Slug,
+			Name: input.Name,
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "genres_slug_key" {
+				v.AddError("slug", "a genre with this slug already exists")
+				return v.OK()
+			}
+
+			return err
+		}
+
+		return setGenreAliases(ctx, q, created.ID, input.Aliases)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformGenre(&created, input.Aliases), nil
+}
+
+// UpdateGenre renames the genre or replaces its aliases. The slug can not be
+// changed, since movies refer to it.
+func (s *MovieService) UpdateGenre(ctx context.Context, slug string, updates PartialGenreUpdate) (*Genre, error) {
+	var (
+		updated storage.Genre
+		aliases []string
+	)
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		row, err := q.GetGenre(ctx, slug)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrGenreNotFound
+			}
+
+			return err
+		}
+
+		input := GenreInput{Slug: row.Genre.Slug, Name: row.Genre.Name, Aliases: row.Aliases}
+		if updates.Name != nil {
+			input.Name = *updates.Name
+		}
+		if updates.Aliases != nil {
+			input.Aliases = genreSlugs(updates.Aliases)
+		}
+		if err := input.OK(); err != nil {
+			return err
+		}
+
+		if updates.Aliases != nil {
+			catalogue, err := loadGenres(ctx, q)
+			if err != nil {
+				return err
+			}
+
+			v := validator.New()
+			catalogue.checkAliases(v, slug, input.Aliases)
+			if err := v.OK(); err != nil {
+				return err
+			}
+		}
+
+		updated, err = q.UpdateGenre(ctx, storage.UpdateGenreParams{
+			Slug: slug,
+			Name: input.Name,
+		})
+		if err != nil {
+			return err
+		}
+		aliases = input.Aliases
+
+		if updates.Aliases == nil {
+			return nil
+		}
+
+		if err := q.DeleteGenreAliases(ctx, updated.ID); err != nil {
+			return err
+		}
+		return setGenreAliases(ctx, q, updated.ID, input.Aliases)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformGenre(&updated, aliases), nil
+}
+
+// DeleteGenre removes the genre and its aliases from the catalogue. Genres
+// which movies still have, including the movies in the trash, can not be
+// deleted.
+func (s *MovieService) DeleteGenre(ctx context.Context, slug string) error {
+	n, err := s.storage.DeleteGenre(ctx, slug)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ErrGenreInUse
+		}
+
+		return err
+	}
+
+	if n == 0 {
+		return ErrGenreNotFound
+	}
+
+	return nil
+}
+
+func setGenreAliases(ctx context.Context, q storage.Querier, genreID int64, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	return q.CreateGenreAliases(ctx, storage.CreateGenreAliasesParams{
+		Aliases: aliases,
+		GenreID: genreID,
+	})
+}
+
+// genreCatalogue maps the slugs and the aliases of the genres to the slugs of
+// the genres.
+type genreCatalogue map[string]string
+
+func loadGenres(ctx context.Context, q storage.Querier) (genreCatalogue, error) {
+	rows, err := q.ListGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogue := genreCatalogue{}
+	for _, row := range rows {
+		catalogue[row.Genre.Slug] = row.Genre.Slug
+		for _, alias := range row.Aliases {
+			catalogue[alias] = row.Genre.Slug
+		}
+	}
+	return catalogue, nil
+}
+
+// canonicalGenres returns the slugs of the genres, see genreCatalogue.resolve.
+func canonicalGenres(ctx context.Context, q storage.Querier, genres []string) ([]string, error) {
+	catalogue, err := loadGenres(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogue.resolve(genres)
+}
+
+// canonicalFilters returns the filters with the genres resolved to their
+// slugs, so that movies can be filtered by any spelling or alias of a genre.
+func (s *MovieService) canonicalFilters(ctx context.Context, filters MovieFilters) (MovieFilters, error) {
+	if len(filters.Genres) == 0 {
+		return filters, nil
+	}
+
+	genres, err := canonicalGenres(ctx, s.storage, filters.Genres)
+	if err != nil {
+		return MovieFilters{}, err
+	}
+	filters.Genres = genres
+	return filters, nil
+}
+
+// resolve returns the slugs of the genres, in order and without the
+// duplicates left by different spellings of the same genre. Unknown genres
+// are rejected with a suggestion of the closest known one, all of them in
+// the one message the validator keeps for genres.
+func (c genreCatalogue) resolve(genres []string) ([]string, error) {
+	var unknown []string
+
+	slugs := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		slug, ok := c[genreSlug(genre)]
+		if !ok {
+			msg := fmt.Sprintf("unknown genre %q", genre)
+			if closest, ok := c.closest(genreSlug(genre)); ok {
+				msg += fmt.Sprintf(", did you mean %q?", closest)
+			}
+			unknown = append(unknown, msg)
+			continue
+		}
+
+		if !slices.Contains(slugs, slug) {
+			slugs = append(slugs, slug)
+		}
+	}
+
+	if len(unknown) > 0 {
+		v := validator.New()
+		v.AddError("genres", strings.Join(unknown, "; "))
+		return nil, v.OK()
+	}
+	return slugs, nil
+}
+
+// closest returns the genre whose slug or alias is the fewest edits away
+// from slug, as long as less than half of slug has to be changed.
+func (c genreCatalogue) closest(slug string) (string, bool) {
+	best, bestDistance := "", len(slug)
+	for spelling, genre := range c {
+		d := levenshtein(slug, spelling)
+		if d < bestDistance || (d == bestDistance && genre < best) {
+			best, bestDistance = genre, d
+		}
+	}
+
+	return best, best != "" && 2*bestDistance <= len(slug)
+}
+
+// checkAliases adds an error for the aliases which are already the slug or an
+// alias of another genre than slug.
+func (c genreCatalogue) checkAliases(v *validator.Validator, slug string, aliases []string) {
+	for _, alias := range aliases {
+		if genre, ok := c[alias]; ok && genre != slug {
+			v.AddError("aliases", fmt.Sprintf("%q is already used by the genre %q", alias, genre))
+		}
+	}
+}
+
+// levenshtein returns the number of single byte insertions, deletions and
+// substitutions needed to turn a into b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func transformGenre(genre *storage.Genre, aliases []string) *Genre {
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return &Genre{
+		Slug:    genre.Slug,
+		Name:    genre.Name,
+		Aliases: aliases,
+	}
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/validator"
+	"k8s.io/utils/ptr"
+)
+
+func TestResolveGenres(t *testing.T) {
+	catalogue := genreCatalogue{
+		"sci-fi":          "sci-fi",
+		"science-fiction": "sci-fi",
+		"drama":           "drama",
+		"war":             "war",
+	}
+
+	tcs := []struct {
+		name          string
+		genres        []string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "slugs",
+			genres:   []string{"drama", "sci-fi"},
+			expected: []string{"drama", "sci-fi"},
+		},
+		{
+			name:     "spellings and aliases",
+			genres:   []string{"Sci-Fi", "Science Fiction", " DRAMA "},
+			expected: []string{"sci-fi", "drama"},
+		},
+		{
+			name:          "unknown genre with a close match",
+			genres:        []string{"drama", "Sci-Fy"},
+			expectedError: `unknown genre "Sci-Fy", did you mean "sci-fi"?`,
+		},
+		{
+			name:          "unknown genre without a close match",
+			genres:        []string{"cat"},
+			expectedError: `unknown genre "cat"`,
+		},
+		{
+			name:          "several unknown genres",
+			genres:        []string{"Sci-Fy", "drama", "cat", "dramma"},
+			expectedError: `unknown genre "Sci-Fy", did you mean "sci-fi"?; unknown genre "cat"; unknown genre "dramma", did you mean "drama"?`,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := catalogue.resolve(tc.genres)
+
+			var validationErr validator.ValidationError
+			if errors.As(err, &validationErr) {
+				if validationErr.Errors["genres"] != tc.expectedError {
+					t.Fatalf("expected error %q; got %q", tc.expectedError, validationErr.Errors["genres"])
+				}
+				return
+			}
+			if err != nil || tc.expectedError != "" {
+				t.Fatalf("expected error %q; got %v", tc.expectedError, err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected genres (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateMovieGenres(t *testing.T) {
+	h := setupTest(t)
+	ctx := context.Background()
+
+	movie, err := h.service.CreateMovie(ctx, MovieInput{
+		Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"Horror", "scifi"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"horror", "sci-fi"}, movie.Genres); diff != "" {
+		t.Errorf("expected the canonical genres (-want +got):\n%s", diff)
+	}
+
+	_, err = h.service.CreateMovie(ctx, MovieInput{
+		Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"horor"},
+	})
+	var validationErr validator.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Errors["genres"] != `unknown genre "horor", did you mean "horror"?` {
+		t.Fatalf("expected the unknown genre to be rejected; got %v", err)
+	}
+
+	updated, err := h.service.UpdateMovie(ctx, movie.ID, PartialMovieUpdate{Genres: []string{"Science Fiction"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"sci-fi"}, updated.Genres); diff != "" {
+		t.Errorf("expected the canonical genres (-want +got):\n%s", diff)
+	}
+}
+
+func TestListMoviesGenreAliases(t *testing.T) {
+	h := setupTest(t)
+	ctx := context.Background()
+
+	alien, err := h.service.CreateMovie(ctx, MovieInput{
+		Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"horror", "sci-fi"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filters := MovieFilters{Genres: []string{"Science Fiction"}}
+
+	movies, _, err := h.service.ListMovies(ctx, filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].ID != alien.ID {
+		t.Errorf("expected the movie with the aliased genre; got %+v", movies)
+	}
+
+	movies, _, err = h.service.ListMoviesKeyset(ctx, filters, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].ID != alien.ID {
+		t.Errorf("expected the movie with the aliased genre; got %+v", movies)
+	}
+
+	exported, err := h.service.ExportMovies(ctx, filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for movie, err := range exported {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, movie.ID)
+	}
+	if diff := cmp.Diff([]int64{alien.ID}, ids); diff != "" {
+		t.Errorf("expected the movie with the aliased genre (-want +got):\n%s", diff)
+	}
+
+	_, _, err = h.service.ListMovies(ctx, MovieFilters{Genres: []string{"horor"}})
+	var validationErr validator.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Errors["genres"] != `unknown genre "horor", did you mean "horror"?` {
+		t.Fatalf("expected the unknown genre to be rejected; got %v", err)
+	}
+}
+
+func TestCreateGenre(t *testing.T) {
+	tcs := []struct {
+		name           string
+		input          GenreInput
+		expected       *Genre
+		expectedFields []string
+	}{
+		{
+			name:     "valid",
+			input:    GenreInput{Slug: "film-noir", Name: "Film Noir", Aliases: []string{"Noir"}},
+			expected: &Genre{Slug: "film-noir", Name: "Film Noir", Aliases: []string{"noir"}},
+		},
+		{
+			name:           "invalid slug",
+			input:          GenreInput{Slug: "Film Noir", Name: "Film Noir"},
+			expectedFields: []string{"slug"},
+		},
+		{
+			name:           "existing slug",
+			input:          GenreInput{Slug: "drama", Name: "Drama"},
+			expectedFields: []string{"slug"},
+		},
+		{
+			name:           "slug used as an alias",
+			input:          GenreInput{Slug: "scifi", Name: "Sci-Fi"},
+			expectedFields: []string{"slug"},
+		},
+		{
+			name:           "alias used by another genre",
+			input:          GenreInput{Slug: "space-opera", Name: "Space Opera", Aliases: []string{"Science Fiction"}},
+			expectedFields: []string{"aliases"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+
+			genre, err := h.service.CreateGenre(context.Background(), tc.input)
+
+			var validationErr validator.ValidationError
+			if len(tc.expectedFields) > 0 {
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected a validation error; got %v", err)
+				}
+				for _, field := range tc.expectedFields {
+					if validationErr.Errors[field] == "" {
+						t.Errorf("expected an error for %s; got %v", field, validationErr.Errors)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expected, genre); diff != "" {
+				t.Errorf("unexpected genre (-want +got):\n%s", diff)
+			}
+			if stored, err := h.service.GetGenre(context.Background(), genre.Slug); err != nil || !cmp.Equal(stored, genre) {
+				t.Errorf("expected the genre to be stored; got %v, %v", stored, err)
+			}
+		})
+	}
+}
+
+func TestUpdateGenre(t *testing.T) {
+	h := setupTest(t)
+	ctx := context.Background()
+
+	genre, err := h.service.UpdateGenre(ctx, "sci-fi", PartialGenreUpdate{
+		Name:    ptr.To("Sci-Fi"),
+		Aliases: []string{"SF", "Space Opera"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Genre{Slug: "sci-fi", Name: "Sci-Fi", Aliases: []string{"sf", "space-opera"}}
+	if diff := cmp.Diff(expected, genre); diff != "" {
+		t.Errorf("unexpected genre (-want +got):\n%s", diff)
+	}
+
+	// The aliases which were replaced no longer map to the genre.
+	_, err = h.service.CreateMovie(ctx, MovieInput{
+		Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"scifi"},
+	})
+	var validationErr validator.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("expected a removed alias to be rejected; got %v", err)
+	}
+
+	_, err = h.service.UpdateGenre(ctx, "drama", PartialGenreUpdate{Aliases: []string{"sf"}})
+	if !errors.As(err, &validationErr) || validationErr.Errors["aliases"] == "" {
+		t.Errorf("expected an alias of another genre to be rejected; got %v", err)
+	}
+
+	_, err = h.service.UpdateGenre(ctx, "noir", PartialGenreUpdate{Name: ptr.To("Noir")})
+	if !errors.Is(err, ErrGenreNotFound) {
+		t.Errorf("expected %v; got %v", ErrGenreNotFound, err)
+	}
+}
+
+func TestDeleteGenre(t *testing.T) {
+	tcs := []struct {
+		name          string
+		slug          string
+		expectedError error
+	}{
+		{
+			name: "unused genre",
+			slug: "western",
+		},
+		{
+			name:          "genre of a movie",
+			slug:          mocks.TestMovie1.Genres[0],
+			expectedError: ErrGenreInUse,
+		},
+		{
+			name:          "missing genre",
+			slug:          "noir",
+			expectedError: ErrGenreNotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+			h.model.Reset(mocks.TestMovie1)
+
+			err := h.service.DeleteGenre(context.Background(), tc.slug)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("expected error %v; got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestImportMoviesGenres(t *testing.T) {
+	h := setupTest(t)
+
+	rows := importRows(
+		ImportRow{Line: 2, Input: MovieInput{Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"Horror", "Sci-Fi"}}},
+		ImportRow{Line: 3, Input: MovieInput{Title: "Up", Year: 2009, RuntimeMin: 96, Genres: []string{"animaton"}}},
+	)
+
+	report, err := h.service.ImportMovies(context.Background(), rows, ImportBestEffort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := report.Rows[1].Errors["genres"]; got != `unknown genre "animaton", did you mean "animation"?` {
+		t.Errorf("expected the unknown genre to be rejected; got %q", got)
+	}
+
+	movies, _, err := h.service.ListMovies(context.Background(), MovieFilters{Genres: []string{"sci-fi"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || !cmp.Equal(movies[0].Genres, []string{"horror", "sci-fi"}) {
+		t.Errorf("expected the imported movie with the canonical genres; got %+v", movies)
+	}
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"maps"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+// importBatchSize is the number of movies sent to the database in one COPY.
+const importBatchSize = 1000
+
+// ImportMode decides what happens to the valid rows of an import when some
+// of the rows are rejected.
+type ImportMode string
+
+const (
+	// ImportAllOrNothing imports nothing when any row is rejected.
+	ImportAllOrNothing ImportMode = "all_or_nothing"
+	// ImportBestEffort imports the valid rows and skips the rejected ones.
+	ImportBestEffort ImportMode = "best_effort"
+)
+
+type ImportRowStatus string
+
+const (
+	ImportRowAccepted ImportRowStatus = "accepted"
+	ImportRowRejected ImportRowStatus = "rejected"
+)
+
+// ErrImportRejected is returned together with the report when an
+// all-or-nothing import was rolled back because of rejected rows.
+var ErrImportRejected = errors.New("import rejected: some rows are invalid")
+
+// ImportRow is a movie read from an import. Errors holds the fields which
+// could not be parsed, the rest of the input is validated by ImportMovies.
+type ImportRow struct {
+	Line   int
+	Input  MovieInput
+	Errors map[string]string
+}
+
+type ImportRowResult struct {
+	Line   int
+	Status ImportRowStatus
+	Errors map[string]string
+}
+
+type ImportReport struct {
+	Mode     ImportMode
+	Imported int
+	Accepted int
+	Rejected int
+	Rows     []ImportRowResult
+}
+
+// ImportMovies validates the rows and inserts the valid ones in batches, in
+// a single transaction, and reports the outcome of every row. An error from
+// rows aborts the import. In ImportAllOrNothing mode the transaction is
+// rolled back when any row is rejected, the report is then returned with
+// ErrImportRejected.
+func (s *MovieService) ImportMovies(ctx context.Context, rows iter.Seq2[ImportRow, error], mode ImportMode) (*ImportReport, error) {
+	v := validator.New()
+	v.Check(validator.PermittedValue(mode, ImportAllOrNothing, ImportBestEffort), "mode", "must be all_or_nothing or best_effort")
+	if err := v.OK(); err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{Mode: mode, Rows: []ImportRowResult{}}
+
+	// The rows can only be read once, so the transaction is not retried.
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		genres, err := loadGenres(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		batch := make([]storage.CreateMoviesParams, 0, importBatchSize)
+
+		flush := func() error {
+			// Once a row is rejected in all-or-nothing mode the
+			// transaction will be rolled back, don't bother inserting.
+			if len(batch) > 0 && (mode == ImportBestEffort || report.Rejected == 0) {
+				n, err := createMovies(ctx, q, batch)
+				if err != nil {
+					return err
+				}
+				report.Imported += int(n)
+			}
+			batch = batch[:0]
+			return nil
+		}
+
+		for row, err := range rows {
+			if err != nil {
+				return err
+			}
+
+			result := ImportRowResult{Line: row.Line, Status: ImportRowAccepted}
+			if errs := validateImportRow(&row, genres); len(errs) > 0 {
+				result.Status = ImportRowRejected
+				result.Errors = errs
+				report.Rejected++
+			} else {
+				report.Accepted++
+				batch = append(batch, storage.CreateMoviesParams{
+					Title:      row.Input.Title,
+					Year:       row.Input.Year,
+					RuntimeMin: row.Input.RuntimeMin,
+					Genres:     row.Input.Genres,
+				})
+			}
+			report.Rows = append(report.Rows, result)
+
+			if len(batch) == importBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+
+		if mode == ImportAllOrNothing && report.Rejected > 0 {
+			return ErrImportRejected
+		}
+		return nil
+	}, storage.WithMaxAttempts(1))
+
+	if errors.Is(err, ErrImportRejected) {
+		report.Imported = 0
+		return report, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// createMovies copies the batch into the movies table together with the
+// first revision of each movie. The IDs are reserved up front so that the
+// revisions can refer to the movies, COPY does not return them.
+func createMovies(ctx context.Context, q storage.Querier, batch []storage.CreateMoviesParams) (int64, error) {
+	ids, err := q.ReserveMovieIDs(ctx, int32(len(batch)))
+	if err != nil {
+		return 0, err
+	}
+
+	actor := actorFrom(ctx)
+	revisions := make([]storage.CreateMovieRevisionsParams, len(batch))
+	for i := range batch {
+		batch[i].ID = ids[i]
+		revisions[i] = storage.CreateMovieRevisionsParams{
+			MovieID:       ids[i],
+			Version:       1,
+			Operation:     string(RevisionCreate),
+			Title:         batch[i].Title,
+			Year:          batch[i].Year,
+			RuntimeMin:    batch[i].RuntimeMin,
+			Genres:        batch[i].Genres,
+			ChangedFields: createdFields(),
+			UserID:        actor.userID(),
+			TraceID:       actor.TraceID,
+		}
+	}
+
+	n, err := q.CreateMovies(ctx, batch)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := q.CreateMovieRevisions(ctx, revisions); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// ImportRowError is the key of the error of a row which could not be parsed
+// at all, the fields of such rows are not validated.
+const ImportRowError = "row"
+
+// validateImportRow returns the parse errors of the row together with the
+// validation errors of the fields which could be parsed. The genres of a
+// valid row are replaced by the slugs of the genres in the catalogue.
+func validateImportRow(row *ImportRow, genres genreCatalogue) map[string]string {
+	if _, ok := row.Errors[ImportRowError]; ok {
+		return row.Errors
+	}
+
+	errs := maps.Clone(row.Errors)
+	if errs == nil {
+		errs = map[string]string{}
+	}
+
+	addErrors := func(err error) {
+		var validationErr validator.ValidationError
+		if errors.As(err, &validationErr) {
+			for field, msg := range validationErr.Errors {
+				if _, ok := errs[field]; !ok {
+					errs[field] = msg
+				}
+			}
+		}
+	}
+
+	addErrors(row.Input.OK())
+	if len(errs) == 0 {
+		slugs, err := genres.resolve(row.Input.Genres)
+		addErrors(err)
+		row.Input.Genres = slugs
+	}
+
+	return errs
+}
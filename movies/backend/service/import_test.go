@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+)
+
+func importRows(rows ...ImportRow) iter.Seq2[ImportRow, error] {
+	return func(yield func(ImportRow, error) bool) {
+		for _, row := range rows {
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestImportMovies(t *testing.T) {
+	valid := ImportRow{Line: 2, Input: MovieInput{Title: "Moana", Year: 2016, RuntimeMin: 107, Genres: []string{"animation"}}}
+	invalid := ImportRow{Line: 3, Input: MovieInput{Title: "Moana", Year: 2016, Genres: []string{"animation"}}}
+	unparsed := ImportRow{Line: 4, Errors: map[string]string{ImportRowError: "bare \" in non-quoted field"}}
+
+	tcs := []struct {
+		name              string
+		rows              []ImportRow
+		mode              ImportMode
+		expectedErr       error
+		expectedImported  int
+		expectedRejected  int
+		expectedCommits   int
+		expectedRollbacks int
+	}{
+		{
+			name:             "all valid",
+			rows:             []ImportRow{valid, valid},
+			mode:             ImportAllOrNothing,
+			expectedImported: 2,
+			expectedCommits:  1,
+		},
+		{
+			name:              "all or nothing with a rejected row",
+			rows:              []ImportRow{valid, invalid},
+			mode:              ImportAllOrNothing,
+			expectedErr:       ErrImportRejected,
+			expectedRejected:  1,
+			expectedRollbacks: 1,
+		},
+		{
+			name:             "best effort with rejected rows",
+			rows:             []ImportRow{valid, invalid, unparsed},
+			mode:             ImportBestEffort,
+			expectedImported: 1,
+			expectedRejected: 2,
+			expectedCommits:  1,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+
+			report, err := h.service.ImportMovies(context.Background(), importRows(tc.rows...), tc.mode)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v; got %v", tc.expectedErr, err)
+			}
+
+			if report.Imported != tc.expectedImported || report.Rejected != tc.expectedRejected {
+				t.Errorf("expected %d imported and %d rejected; got %+v", tc.expectedImported, tc.expectedRejected, report)
+			}
+			if len(report.Rows) != len(tc.rows) {
+				t.Errorf("expected a result for each of the %d rows; got %d", len(tc.rows), len(report.Rows))
+			}
+			if h.model.Commits() != tc.expectedCommits || h.model.Rollbacks() != tc.expectedRollbacks {
+				t.Errorf("expected %d commits and %d rollbacks; got %d and %d",
+					tc.expectedCommits, tc.expectedRollbacks, h.model.Commits(), h.model.Rollbacks())
+			}
+
+			movies, _, err := h.service.ListMovies(context.Background(), MovieFilters{Page: 1, PageSize: 20, Sort: "id"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(movies) != tc.expectedImported {
+				t.Errorf("expected %d movies in storage; got %d", tc.expectedImported, len(movies))
+			}
+		})
+	}
+}
+
+func TestImportMoviesRowErrors(t *testing.T) {
+	h := setupTest(t)
+
+	rows := importRows(
+		ImportRow{Line: 2, Input: MovieInput{Title: "Moana", RuntimeMin: 107, Genres: []string{"animation"}},
+			Errors: map[string]string{"year": "must be an integer"}},
+		ImportRow{Line: 3, Errors: map[string]string{ImportRowError: "extraneous \" in field"}},
+	)
+
+	report, err := h.service.ImportMovies(context.Background(), rows, ImportBestEffort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := report.Rows[0].Errors; got["year"] != "must be an integer" || len(got) != 1 {
+		t.Errorf("expected the parse error to replace the validation error of the field; got %v", got)
+	}
+	if got := report.Rows[1].Errors; len(got) != 1 {
+		t.Errorf("expected only the error of the unparsed row; got %v", got)
+	}
+}
+
+func TestImportMoviesInvalidMode(t *testing.T) {
+	h := setupTest(t)
+
+	_, err := h.service.ImportMovies(context.Background(), importRows(), "sometimes")
+	if err == nil {
+		t.Fatal("expected an invalid mode to be rejected")
+	}
+}
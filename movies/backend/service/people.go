@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+const (
+	personNameMaxLength = 200
+	birthYearMin        = 1800
+	characterMaxLength  = 200
+	billingOrderMax     = 1000
+)
+
+const (
+	RoleDirector        = "director"
+	RoleWriter          = "writer"
+	RoleProducer        = "producer"
+	RoleActor           = "actor"
+	RoleComposer        = "composer"
+	RoleCinematographer = "cinematographer"
+	RoleEditor          = "editor"
+)
+
+// CreditRoles contains the roles a person can be credited with, they match
+// the check constraint of the movie_credits table.
+var CreditRoles = []string{
+	RoleDirector, RoleWriter, RoleProducer, RoleActor, RoleComposer, RoleCinematographer, RoleEditor,
+}
+
+var (
+	ErrPersonNotFound = errors.New("person not found")
+	ErrPersonInUse    = errors.New("person in use")
+	ErrCreditNotFound = errors.New("credit not found")
+)
+
+type Person struct {
+	ID        int64
+	Name      string
+	BirthYear *int32
+}
+
+type PersonInput struct {
+	Name      string
+	BirthYear *int32
+}
+
+type PartialPersonUpdate struct {
+	Name      *string
+	BirthYear *int32
+	// ClearBirthYear removes the birth year, since a nil BirthYear leaves it
+	// unchanged.
+	ClearBirthYear bool
+}
+
+// PeopleFilters narrows down and paginates the people returned by
+// ListPeople. People are ordered by name.
+type PeopleFilters struct {
+	Name     string
+	Page     int32
+	PageSize int32
+}
+
+// Credit is the part a person had in a movie. Character is only set for
+// actors. Credits are listed by billing order.
+type Credit struct {
+	ID           int64
+	MovieID      int64
+	PersonID     int64
+	PersonName   string
+	Role         string
+	Character    string
+	BillingOrder int32
+}
+
+type CreditInput struct {
+	PersonID  int64
+	Role      string
+	Character string
+	// BillingOrder places the credit among the credits of the movie, zero
+	// places it after the existing ones.
+	BillingOrder int32
+}
+
+func (p PersonInput) OK() error {
+	v := validator.New()
+
+	v.Check(p.Name != "", "name", errMustBeProvided)
+	v.Check(len(p.Name) <= personNameMaxLength, "name", "must not be more than 200 bytes long")
+
+	if p.BirthYear != nil {
+		v.Check(*p.BirthYear >= birthYearMin, "birthYear", "must be 1800 or later")
+		v.Check(int(*p.BirthYear) <= time.Now().Year(), "birthYear", "must not be in the future")
+	}
+
+	return v.OK()
+}
+
+func (f PeopleFilters) OK() error {
+	v := validator.New()
+
+	v.Check(f.Page > 0, "page", "must be greater than zero")
+	v.Check(f.Page <= pageMax, "page", "must be a maximum of 10 million")
+
+	v.Check(f.PageSize > 0, "page_size", "must be greater than zero")
+	v.Check(f.PageSize <= pageSizeMax, "page_size", "must be a maximum of 100")
+
+	return v.OK()
+}
+
+// WithDefaults returns a copy of the filters with the paging fields set to
+// their defaults when they were left empty.
+func (f PeopleFilters) WithDefaults() PeopleFilters {
+	if f.Page == 0 {
+		f.Page = DefaultPage
+	}
+	if f.PageSize == 0 {
+		f.PageSize = DefaultPageSize
+	}
+	return f
+}
+
+func (c CreditInput) OK() error {
+	v := validator.New()
+
+	v.Check(c.PersonID != 0, "personId", errMustBeProvided)
+	v.Check(c.PersonID >= 0, "personId", "must be a positive integer")
+
+	v.Check(c.Role != "", "role", errMustBeProvided)
+	v.Check(c.Role == "" || validator.PermittedValue(c.Role, CreditRoles...), "role", "invalid role")
+
+	v.Check(c.Character == "" || c.Role == RoleActor, "character", "must only be set for actors")
+	v.Check(len(c.Character) <= characterMaxLength, "character", "must not be more than 200 bytes long")
+
+	v.Check(c.BillingOrder >= 0, "billingOrder", "must not be negative")
+	v.Check(c.BillingOrder <= billingOrderMax, "billingOrder", "must be a maximum of 1000")
+
+	return v.OK()
+}
+
+func (s *MovieService) ListPeople(ctx context.Context, filters PeopleFilters) ([]*Person, Metadata, error) {
+	filters = filters.WithDefaults()
+	if err := filters.OK(); err != nil {
+		return nil, Metadata{}, err
+	}
+
+	rows, err := s.storage.ListPeople(ctx, storage.ListPeopleParams{
+		Name:       filters.Name,
+		PageSize:   filters.PageSize,
+		PageOffset: (filters.Page - 1) * filters.PageSize,
+	})
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	var totalRecords int64
+	people := make([]*Person, len(rows))
+	for i, row := range rows {
+		totalRecords = row.TotalRecords
+		people[i] = transformPerson(&row.Person)
+	}
+	return people, calculateMetadata(totalRecords, filters.Page, filters.PageSize), nil
+}
+
+func (s *MovieService) GetPerson(ctx context.Context, id int64) (*Person, error) {
+	person, err := s.storage.GetPerson(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPersonNotFound
+		}
+
+		return nil, err
+	}
+
+	return transformPerson(&person), nil
+}
+
+func (s *MovieService) CreatePerson(ctx context.Context, input PersonInput) (*Person, error) {
+	if err := input.OK(); err != nil {
+		return nil, err
+	}
+
+	person, err := s.storage.CreatePerson(ctx, storage.CreatePersonParams{
+		Name:      input.Name,
+		BirthYear: birthYear(input.BirthYear),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformPerson(&person), nil
+}
+
+func (s *MovieService) UpdatePerson(ctx context.Context, id int64, updates PartialPersonUpdate) (*Person, error) {
+	v := validator.New()
+	v.Check(!updates.ClearBirthYear || updates.BirthYear == nil, "birthYear", "must not be set when clearing the birth year")
+	if err := v.OK(); err != nil {
+		return nil, err
+	}
+
+	var updated storage.Person
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		person, err := q.GetPerson(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPersonNotFound
+			}
+
+			return err
+		}
+
+		input := PersonInput{Name: person.Name}
+		if person.BirthYear.Valid {
+			input.BirthYear = &person.BirthYear.Int32
+		}
+		if updates.Name != nil {
+			input.Name = *updates.Name
+		}
+		if updates.BirthYear != nil {
+			input.BirthYear = updates.BirthYear
+		}
+		if updates.ClearBirthYear {
+			input.BirthYear = nil
+		}
+		if err := input.OK(); err != nil {
+			return err
+		}
+
+		updated, err = q.UpdatePerson(ctx, storage.UpdatePersonParams{
+			ID:        id,
+			Name:      input.Name,
+			BirthYear: birthYear(input.BirthYear),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformPerson(&updated), nil
+}
+
+// DeletePerson removes the person. People who are credited on a movie,
+// including the movies in the trash, can not be deleted.
+func (s *MovieService) DeletePerson(ctx context.Context, id int64) error {
+	n, err := s.storage.DeletePerson(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ErrPersonInUse
+		}
+
+		return err
+	}
+
+	if n == 0 {
+		return ErrPersonNotFound
+	}
+
+	return nil
+}
+
+// ListMovieCredits returns the cast and crew of the movie in billing order.
+func (s *MovieService) ListMovieCredits(ctx context.Context, movieID int64) ([]*Credit, error) {
+	if _, err := s.GetMovie(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.storage.ListMovieCredits(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	credits := make([]*Credit, len(rows))
+	for i, row := range rows {
+		credits[i] = transformCredit(&row.MovieCredit, row.PersonName)
+	}
+	return credits, nil
+}
+
+// AddMovieCredit credits the person on the movie. Credits are not part of
+// the history of the movie and do not change its version.
+func (s *MovieService) AddMovieCredit(ctx context.Context, movieID int64, input CreditInput) (*Credit, error) {
+	if err := input.OK(); err != nil {
+		return nil, err
+	}
+
+	var (
+		created storage.MovieCredit
+		person  storage.Person
+	)
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		if _, err := q.GetMovie(ctx, movieID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrMovieNotFound
+			}
+
+			return err
+		}
+
+		v := validator.New()
+
+		var err error
+		person, err = q.GetPerson(ctx, input.PersonID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				v.AddError("personId", "person not found")
+				return v.OK()
+			}
+
+			return err
+		}
+
+		if input.BillingOrder == 0 {
+			credits, err := q.ListMovieCredits(ctx, movieID)
+			if err != nil {
+				return err
+			}
+
+			input.BillingOrder = 1
+			for _, credit := range credits {
+				input.BillingOrder = max(input.BillingOrder, credit.MovieCredit.BillingOrder+1)
+			}
+		}
+
+		created, err = q.CreateMovieCredit(ctx, storage.CreateMovieCreditParams{
+			MovieID:       movieID,
+			PersonID:      input.PersonID,
+			Role:          input.Role,
+			CharacterName: input.Character,
+			BillingOrder:  input.BillingOrder,
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				v.AddError("personId", "already has this credit on the movie")
+				return v.OK()
+			}
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformCredit(&created, person.Name), nil
+}
+
+func (s *MovieService) DeleteMovieCredit(ctx context.Context, movieID, creditID int64) error {
+	if _, err := s.GetMovie(ctx, movieID); err != nil {
+		return err
+	}
+
+	n, err := s.storage.DeleteMovieCredit(ctx, storage.DeleteMovieCreditParams{
+		ID:      creditID,
+		MovieID: movieID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ErrCreditNotFound
+	}
+
+	return nil
+}
+
+// birthYear is NULL when the birth year of the person is unknown.
+func birthYear(year *int32) pgtype.Int4 {
+	if year == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *year, Valid: true}
+}
+
+func transformPerson(person *storage.Person) *Person {
+	p := &Person{
+		ID:   person.ID,
+		Name: person.Name,
+	}
+
+	if person.BirthYear.Valid {
+		p.BirthYear = &person.BirthYear.Int32
+	}
+
+	return p
+}
+
+func transformCredit(credit *storage.MovieCredit, personName string) *Credit {
+	return &Credit{
+		ID:           credit.ID,
+		MovieID:      credit.MovieID,
+		PersonID:     credit.PersonID,
+		PersonName:   personName,
+		Role:         credit.Role,
+		Character:    credit.CharacterName,
+		BillingOrder: credit.BillingOrder,
+	}
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/validator"
+	"k8s.io/utils/ptr"
+)
+
+func TestCreatePerson(t *testing.T) {
+	tcs := []struct {
+		name           string
+		input          PersonInput
+		expected       *Person
+		expectedFields []string
+	}{
+		{
+			name:     "valid",
+			input:    PersonInput{Name: "Ridley Scott", BirthYear: ptr.To[int32](1937)},
+			expected: &Person{ID: 1, Name: "Ridley Scott", BirthYear: ptr.To[int32](1937)},
+		},
+		{
+			name:     "unknown birth year",
+			input:    PersonInput{Name: "Sigourney Weaver"},
+			expected: &Person{ID: 1, Name: "Sigourney Weaver"},
+		},
+		{
+			name:           "missing name",
+			input:          PersonInput{},
+			expectedFields: []string{"name"},
+		},
+		{
+			name:           "birth year in the future",
+			input:          PersonInput{Name: "Ridley Scott", BirthYear: ptr.To[int32](3000)},
+			expectedFields: []string{"birthYear"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+
+			person, err := h.service.CreatePerson(context.Background(), tc.input)
+
+			var validationErr validator.ValidationError
+			if len(tc.expectedFields) > 0 {
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected a validation error; got %v", err)
+				}
+				for _, field := range tc.expectedFields {
+					if validationErr.Errors[field] == "" {
+						t.Errorf("expected an error for %s; got %v", field, validationErr.Errors)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expected, person); diff != "" {
+				t.Errorf("unexpected person (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdatePerson(t *testing.T) {
+	h := setupTest(t)
+	ctx := context.Background()
+
+	person, err := h.service.CreatePerson(ctx, PersonInput{Name: "Ridley Scot", BirthYear: ptr.To[int32](1937)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := h.service.UpdatePerson(ctx, person.ID, PartialPersonUpdate{Name: ptr.To("Ridley Scott")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Person{ID: person.ID, Name: "Ridley Scott", BirthYear: ptr.To[int32](1937)}
+	if diff := cmp.Diff(expected, updated); diff != "" {
+		t.Errorf("unexpected person (-want +got):\n%s", diff)
+	}
+
+	updated, err = h.service.UpdatePerson(ctx, person.ID, PartialPersonUpdate{ClearBirthYear: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &Person{ID: person.ID, Name: "Ridley Scott"}
+	if diff := cmp.Diff(expected, updated); diff != "" {
+		t.Errorf("expected the birth year to be cleared (-want +got):\n%s", diff)
+	}
+
+	_, err = h.service.UpdatePerson(ctx, person.ID, PartialPersonUpdate{BirthYear: ptr.To[int32](1937), ClearBirthYear: true})
+	var validationErr validator.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Errors["birthYear"] == "" {
+		t.Errorf("expected setting and clearing the birth year to be rejected; got %v", err)
+	}
+
+	_, err = h.service.UpdatePerson(ctx, 42, PartialPersonUpdate{Name: ptr.To("Nobody")})
+	if !errors.Is(err, ErrPersonNotFound) {
+		t.Errorf("expected %v; got %v", ErrPersonNotFound, err)
+	}
+}
+
+func TestListPeople(t *testing.T) {
+	h := setupTest(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Sigourney Weaver", "Ridley Scott", "Tony Scott"} {
+		if _, err := h.service.CreatePerson(ctx, PersonInput{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	people, metadata, err := h.service.ListPeople(ctx, PeopleFilters{Name: "scott", PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 1 || people[0].Name != "Ridley Scott" {
+		t.Errorf("expected the first person named scott; got %+v", people)
+	}
+	if metadata.TotalRecords != 2 || metadata.LastPage != 2 {
+		t.Errorf("expected two pages of one person; got %+v", metadata)
+	}
+
+	_, _, err = h.service.ListPeople(ctx, PeopleFilters{PageSize: 1000})
+	var validationErr validator.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Errors["page_size"] == "" {
+		t.Errorf("expected the page size to be rejected; got %v", err)
+	}
+}
+
+func TestMovieCredits(t *testing.T) {
+	h := setupTest(t)
+	h.model.Reset(mocks.TestMovie1)
+	ctx := context.Background()
+	movieID := mocks.TestMovie1.ID
+
+	director, err := h.service.CreatePerson(ctx, PersonInput{Name: "Etienne Comar"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	actor, err := h.service.CreatePerson(ctx, PersonInput{Name: "Reda Kateb"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := h.service.AddMovieCredit(ctx, movieID, CreditInput{
+		PersonID: actor.ID, Role: RoleActor, Character: "Django Reinhardt", BillingOrder: 2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.service.AddMovieCredit(ctx, movieID, CreditInput{
+		PersonID: director.ID, Role: RoleDirector, BillingOrder: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	writer, err := h.service.AddMovieCredit(ctx, movieID, CreditInput{PersonID: director.ID, Role: RoleWriter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if writer.BillingOrder != 3 {
+		t.Errorf("expected the credit without a billing order to come last; got %d", writer.BillingOrder)
+	}
+
+	credits, err := h.service.ListMovieCredits(ctx, movieID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type summary struct {
+		PersonName string
+		Role       string
+		Character  string
+	}
+	var got []summary
+	for _, c := range credits {
+		got = append(got, summary{c.PersonName, c.Role, c.Character})
+	}
+	expected := []summary{
+		{"Etienne Comar", RoleDirector, ""},
+		{"Reda Kateb", RoleActor, "Django Reinhardt"},
+		{"Etienne Comar", RoleWriter, ""},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected credits (-want +got):\n%s", diff)
+	}
+
+	movies, _, err := h.service.ListMovies(ctx, MovieFilters{PersonID: actor.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].ID != movieID {
+		t.Errorf("expected the movie of the actor; got %+v", movies)
+	}
+
+	if err := h.service.DeletePerson(ctx, actor.ID); !errors.Is(err, ErrPersonInUse) {
+		t.Errorf("expected %v; got %v", ErrPersonInUse, err)
+	}
+
+	if err := h.service.DeleteMovieCredit(ctx, movieID, writer.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.service.DeleteMovieCredit(ctx, movieID, writer.ID); !errors.Is(err, ErrCreditNotFound) {
+		t.Errorf("expected %v; got %v", ErrCreditNotFound, err)
+	}
+}
+
+func TestAddMovieCredit(t *testing.T) {
+	tcs := []struct {
+		name           string
+		movieID        int64
+		input          CreditInput
+		expectedError  error
+		expectedFields []string
+	}{
+		{
+			name:           "missing person and role",
+			movieID:        mocks.TestMovie1.ID,
+			input:          CreditInput{},
+			expectedFields: []string{"personId", "role"},
+		},
+		{
+			name:           "unknown role",
+			movieID:        mocks.TestMovie1.ID,
+			input:          CreditInput{PersonID: 1, Role: "gaffer"},
+			expectedFields: []string{"role"},
+		},
+		{
+			name:           "character of a director",
+			movieID:        mocks.TestMovie1.ID,
+			input:          CreditInput{PersonID: 1, Role: RoleDirector, Character: "Django"},
+			expectedFields: []string{"character"},
+		},
+		{
+			name:           "missing person",
+			movieID:        mocks.TestMovie1.ID,
+			input:          CreditInput{PersonID: 42, Role: RoleDirector},
+			expectedFields: []string{"personId"},
+		},
+		{
+			name:           "duplicate credit",
+			movieID:        mocks.TestMovie1.ID,
+			input:          CreditInput{PersonID: 1, Role: RoleActor, Character: "Django"},
+			expectedFields: []string{"personId"},
+		},
+		{
+			name:          "missing movie",
+			movieID:       42,
+			input:         CreditInput{PersonID: 1, Role: RoleDirector},
+			expectedError: ErrMovieNotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+			h.model.Reset(mocks.TestMovie1)
+			ctx := context.Background()
+
+			person, err := h.service.CreatePerson(ctx, PersonInput{Name: "Reda Kateb"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := h.service.AddMovieCredit(ctx, mocks.TestMovie1.ID, CreditInput{
+				PersonID: person.ID, Role: RoleActor, Character: "Django",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = h.service.AddMovieCredit(ctx, tc.movieID, tc.input)
+
+			if tc.expectedError != nil {
+				if !errors.Is(err, tc.expectedError) {
+					t.Fatalf("expected error %v; got %v", tc.expectedError, err)
+				}
+				return
+			}
+
+			var validationErr validator.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error; got %v", err)
+			}
+			for _, field := range tc.expectedFields {
+				if validationErr.Errors[field] == "" {
+					t.Errorf("expected an error for %s; got %v", field, validationErr.Errors)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+const (
+	PermissionMoviesWrite      = "movies:write"
+	PermissionPermissionsAdmin = "permissions:admin"
+)
+
+// PermissionCodes contains every permission which can be granted, they are
+// seeded by the migrations.
+var PermissionCodes = []string{PermissionMoviesWrite, PermissionPermissionsAdmin}
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// GetPermissions returns the codes of the permissions granted to the user.
+func (s *UserService) GetPermissions(ctx context.Context, userID int64) ([]string, error) {
+	if err := s.checkUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.permissionsForUser(ctx, userID)
+}
+
+// GrantPermission grants the permission to the user and returns all of the
+// user's permissions. Granting a permission twice is not an error.
+func (s *UserService) GrantPermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	if err := checkPermissionCode(code); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := s.storage.AddPermissionForUser(ctx, storage.AddPermissionForUserParams{
+		UserID: userID,
+		Code:   code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.permissionsForUser(ctx, userID)
+}
+
+// RevokePermission takes the permission away from the user and returns the
+// user's remaining permissions.
+func (s *UserService) RevokePermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	if err := checkPermissionCode(code); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := s.storage.RemovePermissionForUser(ctx, storage.RemovePermissionForUserParams{
+		UserID: userID,
+		Code:   code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.permissionsForUser(ctx, userID)
+}
+
+func (s *UserService) permissionsForUser(ctx context.Context, userID int64) ([]string, error) {
+	codes, err := s.storage.GetPermissionsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if codes == nil {
+		codes = []string{}
+	}
+
+	return codes, nil
+}
+
+func (s *UserService) checkUserExists(ctx context.Context, userID int64) error {
+	_, err := s.storage.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func checkPermissionCode(code string) error {
+	v := validator.New()
+	v.Check(code != "", "code", errMustBeProvided)
+	v.Check(validator.PermittedValue(code, PermissionCodes...), "code", "must be a known permission")
+	return v.OK()
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+func TestGrantAndRevokePermission(t *testing.T) {
+	h := setupTest(t)
+	h.model.AddUsers(storage.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 1})
+	us := NewUserService(h.model, &mocks.Notifier[*User]{})
+
+	tcs := []struct {
+		name          string
+		grant         bool
+		userID        int64
+		code          string
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:     "grant",
+			grant:    true,
+			userID:   1,
+			code:     PermissionMoviesWrite,
+			expected: []string{PermissionMoviesWrite},
+		},
+		{
+			name:     "grant twice",
+			grant:    true,
+			userID:   1,
+			code:     PermissionMoviesWrite,
+			expected: []string{PermissionMoviesWrite},
+		},
+		{
+			name:          "grant unknown permission",
+			grant:         true,
+			userID:        1,
+			code:          "movies:delete",
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "grant to unknown user",
+			grant:         true,
+			userID:        2,
+			code:          PermissionMoviesWrite,
+			expectedError: ErrUserNotFound,
+		},
+		{
+			name:     "revoke",
+			userID:   1,
+			code:     PermissionMoviesWrite,
+			expected: []string{},
+		},
+		{
+			name:     "revoke permission which was not granted",
+			userID:   1,
+			code:     PermissionPermissionsAdmin,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				permissions []string
+				err         error
+			)
+			if tc.grant {
+				permissions, err = us.GrantPermission(context.Background(), tc.userID, tc.code)
+			} else {
+				permissions, err = us.RevokePermission(context.Background(), tc.userID, tc.code)
+			}
+
+			h.assertError(tc.expectedError, err)
+			if tc.expectedError == nil && !slices.Equal(permissions, tc.expected) {
+				t.Errorf("expected permissions %v; got %v", tc.expected, permissions)
+			}
+		})
+	}
+}
+
+func TestAuthenticateUserLoadsPermissions(t *testing.T) {
+	h := setupTest(t)
+	us := NewUserService(h.model, &mocks.Notifier[*User]{})
+
+	user, err := us.RegisterUser(context.Background(), UserInput{
+		Name:     "Alice Smith",
+		Email:    "alice@example.com",
+		Password: "pa55word",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := us.GrantPermission(context.Background(), user.ID, PermissionMoviesWrite); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := us.CreateAuthenticationToken(context.Background(), "alice@example.com", "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authenticated, err := us.AuthenticateUser(context.Background(), token.Plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !authenticated.HasPermission(PermissionMoviesWrite) {
+		t.Errorf("expected user to have %s; got %v", PermissionMoviesWrite, authenticated.Permissions)
+	}
+	if authenticated.HasPermission(PermissionPermissionsAdmin) {
+		t.Errorf("did not expect user to have %s", PermissionPermissionsAdmin)
+	}
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// RevisionOperation is the change which produced a revision.
+type RevisionOperation string
+
+const (
+	RevisionCreate  RevisionOperation = "create"
+	RevisionUpdate  RevisionOperation = "update"
+	RevisionDelete  RevisionOperation = "delete"
+	RevisionRestore RevisionOperation = "restore"
+	RevisionRevert  RevisionOperation = "revert"
+	// RevisionSnapshot is the state of the movies which existed before the
+	// history was recorded.
+	RevisionSnapshot RevisionOperation = "snapshot"
+)
+
+// revisionFields are the names of the fields compared between revisions,
+// matching the names used by the API.
+const (
+	fieldTitle      = "title"
+	fieldYear       = "year"
+	fieldRuntimeMin = "runtimeMin"
+	fieldGenres     = "genres"
+	fieldDeletedAt  = "deletedAt"
+)
+
+// Revision is the state of a movie after a change, together with who made
+// the change.
+type Revision struct {
+	// Movie is the snapshot of the movie, its version is the version of the
+	// revision.
+	Movie         *Movie
+	Operation     RevisionOperation
+	ChangedFields []string
+	// UserID is nil when the change was not made by an authenticated user,
+	// or when the user has since been deleted.
+	UserID    *int64
+	TraceID   string
+	CreatedAt time.Time
+}
+
+// Actor is who makes the changes recorded in the history of movies.
+type Actor struct {
+	UserID  int64
+	TraceID string
+}
+
+type actorKey struct{}
+
+// WithActor returns a context in which changes to movies are recorded as
+// made by the actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
+
+// userID is NULL for changes which were not made by a user, such as those
+// of the admin CLI.
+func (a Actor) userID() pgtype.Int8 {
+	return pgtype.Int8{Int64: a.UserID, Valid: a.UserID != 0}
+}
+
+// MovieHistory returns the revisions of the movie, newest first. The history
+// of deleted movies can still be read.
+func (s *MovieService) MovieHistory(ctx context.Context, id int64) ([]*Revision, error) {
+	revisions, err := s.storage.ListMovieRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		return nil, ErrMovieNotFound
+	}
+
+	response := make([]*Revision, len(revisions))
+	for i, revision := range revisions {
+		response[i] = transformRevision(&revision)
+	}
+	return response, nil
+}
+
+// GetMovieRevision returns the revision which produced the given version of
+// the movie.
+func (s *MovieService) GetMovieRevision(ctx context.Context, id int64, version int32) (*Revision, error) {
+	revision, err := s.getRevision(ctx, s.storage, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return transformRevision(&revision), nil
+}
+
+func (s *MovieService) getRevision(ctx context.Context, q storage.Querier, id int64, version int32) (storage.MovieRevision, error) {
+	revision, err := q.GetMovieRevision(ctx, storage.GetMovieRevisionParams{
+		MovieID: id,
+		Version: version,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.MovieRevision{}, ErrRevisionNotFound
+		}
+
+		return storage.MovieRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// RevertMovie applies the snapshot of the given version to the movie as a
+// new version. Deleted movies have to be restored first.
+func (s *MovieService) RevertMovie(ctx context.Context, id int64, version int32) (*Movie, error) {
+	var updated storage.Movie
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		movie, err := q.GetMovie(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrMovieNotFound
+			}
+
+			return err
+		}
+
+		revision, err := s.getRevision(ctx, q, id, version)
+		if err != nil {
+			return err
+		}
+
+		// The rules may have changed since the revision was made.
+		input := MovieInput{
+			Title:      revision.Title,
+			Year:       revision.Year,
+			RuntimeMin: revision.RuntimeMin,
+			Genres:     revision.Genres,
+		}
+		if err := input.OK(); err != nil {
+			return err
+		}
+
+		// Older revisions may have spellings which are now aliases.
+		input.Genres, err = canonicalGenres(ctx, q, input.Genres)
+		if err != nil {
+			return err
+		}
+
+		updated, err = q.UpdateMovie(ctx, storage.UpdateMovieParams{
+			ID:         id,
+			Title:      input.Title,
+			Year:       input.Year,
+			RuntimeMin: input.RuntimeMin,
+			Genres:     input.Genres,
+			Version:    movie.Version,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrEditConflict
+			}
+
+			return err
+		}
+
+		return recordRevision(ctx, q, RevisionRevert, &updated, changedFields(&movie, &updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transform(&updated), nil
+}
+
+// recordRevision adds the state of the movie after a change to its history,
+// in the transaction of the change.
+func recordRevision(
+	ctx context.Context, q storage.Querier, op RevisionOperation, movie *storage.Movie, changed []string,
+) error {
+	actor := actorFrom(ctx)
+
+	return q.CreateMovieRevision(ctx, storage.CreateMovieRevisionParams{
+		MovieID:       movie.ID,
+		Version:       movie.Version,
+		Operation:     string(op),
+		Title:         movie.Title,
+		Year:          movie.Year,
+		RuntimeMin:    movie.RuntimeMin,
+		Genres:        movie.Genres,
+		ChangedFields: changed,
+		UserID:        actor.userID(),
+		TraceID:       actor.TraceID,
+	})
+}
+
+// createdFields are the changed fields of a new movie.
+func createdFields() []string {
+	return []string{fieldTitle, fieldYear, fieldRuntimeMin, fieldGenres}
+}
+
+// changedFields returns the fields which differ between the two states of a
+// movie.
+func changedFields(before, after *storage.Movie) []string {
+	changed := []string{}
+	if before.Title != after.Title {
+		changed = append(changed, fieldTitle)
+	}
+	if before.Year != after.Year {
+		changed = append(changed, fieldYear)
+	}
+	if before.RuntimeMin != after.RuntimeMin {
+		changed = append(changed, fieldRuntimeMin)
+	}
+	if !slices.Equal(before.Genres, after.Genres) {
+		changed = append(changed, fieldGenres)
+	}
+	return changed
+}
+
+func transformRevision(revision *storage.MovieRevision) *Revision {
+	r := &Revision{
+		Movie: &Movie{
+			ID:         revision.MovieID,
+			Title:      revision.Title,
+			Year:       revision.Year,
+			RuntimeMin: revision.RuntimeMin,
+			Genres:     revision.Genres,
+			Version:    revision.Version,
+		},
+		Operation:     RevisionOperation(revision.Operation),
+		ChangedFields: revision.ChangedFields,
+		TraceID:       revision.TraceID,
+		CreatedAt:     revision.CreatedAt.Time,
+	}
+
+	if revision.UserID.Valid {
+		r.UserID = &revision.UserID.Int64
+	}
+	if r.Operation == RevisionDelete {
+		r.Movie.DeletedAt = &r.CreatedAt
+	}
+
+	return r
+}
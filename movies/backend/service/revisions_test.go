@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"k8s.io/utils/ptr"
+)
+
+func TestMovieHistory(t *testing.T) {
+	h := setupTest(t)
+	h.model.Reset(mocks.TestMovie1)
+
+	ctx := WithActor(context.Background(), Actor{UserID: 7, TraceID: "trace"})
+	id := mocks.TestMovie1.ID
+
+	if _, err := h.service.UpdateMovie(ctx, id, PartialMovieUpdate{Year: ptr.To[int32](2012)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.service.DeleteMovie(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.service.RestoreMovie(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+
+	reverted, err := h.service.RevertMovie(ctx, id, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverted.Year != mocks.TestMovie1.Year || reverted.Version != 3 {
+		t.Fatalf("expected the year of version 1 as version 3; got %+v", reverted)
+	}
+
+	revisions, err := h.service.MovieHistory(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		Operation     RevisionOperation
+		Version       int32
+		ChangedFields []string
+	}
+	var got []summary
+	for _, r := range revisions {
+		got = append(got, summary{r.Operation, r.Movie.Version, r.ChangedFields})
+	}
+	expected := []summary{
+		{RevisionRevert, 3, []string{"year"}},
+		{RevisionRestore, 2, []string{"deletedAt"}},
+		{RevisionDelete, 2, []string{"deletedAt"}},
+		{RevisionUpdate, 2, []string{"year"}},
+		{RevisionSnapshot, 1, []string{}},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected history (-want +got):\n%s", diff)
+	}
+
+	if update := revisions[3]; ptr.Deref(update.UserID, 0) != 7 || update.TraceID != "trace" {
+		t.Errorf("expected the update to be attributed to the actor; got %+v", update)
+	}
+	if snapshot := revisions[4]; snapshot.UserID != nil {
+		t.Errorf("expected the snapshot not to be attributed; got %+v", snapshot)
+	}
+	if deleted := revisions[2]; deleted.Movie.DeletedAt == nil {
+		t.Errorf("expected the snapshot of a delete to be deleted; got %+v", deleted.Movie)
+	}
+
+	revision, err := h.service.GetMovieRevision(ctx, id, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Operation != RevisionUpdate || revision.Movie.Year != 2012 {
+		t.Errorf("expected the update which produced version 2; got %+v", revision)
+	}
+}
+
+func TestCreateMovieRevision(t *testing.T) {
+	h := setupTest(t)
+
+	movie, err := h.service.CreateMovie(context.Background(), MovieInput{
+		Title: "Casablanca", Year: 1942, RuntimeMin: 102, Genres: []string{"drama"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, err := h.service.MovieHistory(context.Background(), movie.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 1 || revisions[0].Operation != RevisionCreate || len(revisions[0].ChangedFields) != 4 {
+		t.Fatalf("expected a create revision with every field; got %+v", revisions)
+	}
+	if revisions[0].UserID != nil || h.model.Commits() != 1 {
+		t.Errorf("expected an anonymous revision written in one transaction; got %+v", revisions[0])
+	}
+}
+
+func TestRevertMovie(t *testing.T) {
+	tcs := []struct {
+		name          string
+		deleted       bool
+		version       int32
+		injectDBError error
+		expectedError error
+	}{
+		{
+			name:          "missing version",
+			version:       5,
+			expectedError: ErrRevisionNotFound,
+		},
+		{
+			name:          "deleted movie",
+			deleted:       true,
+			version:       1,
+			expectedError: ErrMovieNotFound,
+		},
+		{
+			name:          "db error",
+			version:       1,
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := setupTest(t)
+			h.model.Reset(mocks.TestMovie1)
+
+			if tc.deleted {
+				if err := h.service.DeleteMovie(context.Background(), mocks.TestMovie1.ID); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			_, err := h.service.RevertMovie(context.Background(), mocks.TestMovie1.ID, tc.version)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("expected error %v; got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestMovieHistoryNotFound(t *testing.T) {
+	h := setupTest(t)
+
+	_, err := h.service.MovieHistory(context.Background(), 42)
+	if !errors.Is(err, ErrMovieNotFound) {
+		t.Fatalf("expected %v; got %v", ErrMovieNotFound, err)
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSearchMoviesPostgres(t *testing.T) {
+	s := New(setupTestStorage(t))
+
+	tcs := []struct {
+		name          string
+		query         string
+		expectedTitle string
+		expectedMark  string
+	}{
+		{
+			name:          "exact word",
+			query:         "knight",
+			expectedTitle: "The Dark Knight",
+			expectedMark:  "<mark>Knight</mark>",
+		},
+		{
+			name:          "stemmed word",
+			query:         "samurais",
+			expectedTitle: "Seven Samurai",
+			expectedMark:  "<mark>Samurai</mark>",
+		},
+		{
+			name:          "misspelled",
+			query:         "godfathr",
+			expectedTitle: "The Godfather",
+		},
+		{
+			name:          "partial word",
+			query:         "shawsh",
+			expectedTitle: "The Shawshank Redemption",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := s.SearchMovies(context.Background(), tc.query, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(results) == 0 {
+				t.Fatalf("expected %q to match %q; got no results", tc.query, tc.expectedTitle)
+			}
+
+			top := results[0]
+			if top.Movie.Title != tc.expectedTitle {
+				t.Fatalf("expected top result for %q to be %q; got %q", tc.query, tc.expectedTitle, top.Movie.Title)
+			}
+			if top.Score <= 0 {
+				t.Fatalf("expected a positive score; got %f", top.Score)
+			}
+			if tc.expectedMark != "" && !strings.Contains(top.Snippet, tc.expectedMark) {
+				t.Fatalf("expected snippet %q to contain %q", top.Snippet, tc.expectedMark)
+			}
+
+			for i := 1; i < len(results); i++ {
+				if results[i].Score > results[i-1].Score {
+					t.Fatalf("expected results to be ordered by score; got %f after %f", results[i].Score, results[i-1].Score)
+				}
+			}
+		})
+	}
+}
@@ -2,22 +2,50 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
 )
 
 var (
 	ErrMovieNotFound = errors.New("movie not found")
+	ErrEditConflict  = errors.New("edit conflict")
 )
 
 type MovieService struct {
-	storage storage.Querier
+	storage storage.Store
+	cursors cursorCodec
 }
 
-func New(s storage.Querier) *MovieService {
-	return &MovieService{storage: s}
+type Option func(*MovieService)
+
+// WithCursorSecret sets the key used to sign keyset pagination cursors.
+// Without it a random key is used, which only suits development: cursors
+// then neither survive a restart nor are accepted by other instances.
+func WithCursorSecret(secret []byte) Option {
+	return func(s *MovieService) {
+		s.cursors = cursorCodec{secret: secret}
+	}
+}
+
+func New(s storage.Store, opts ...Option) *MovieService {
+	ms := &MovieService{
+		storage: s,
+		cursors: cursorCodec{secret: []byte(rand.Text())},
+	}
+
+	for _, opt := range opts {
+		opt(ms)
+	}
+
+	return ms
 }
 
 func (s *MovieService) CreateMovie(ctx context.Context, input MovieInput) (*Movie, error) {
@@ -26,11 +54,24 @@ func (s *MovieService) CreateMovie(ctx context.Context, input MovieInput) (*Movi
 		return nil, err
 	}
 
-	movie, err := s.storage.CreateMovie(ctx, storage.CreateMovieParams{
-		Title:      input.Title,
-		Year:       input.Year,
-		RuntimeMin: input.RuntimeMin,
-		Genres:     input.Genres,
+	var movie storage.Movie
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		genres, err := canonicalGenres(ctx, q, input.Genres)
+		if err != nil {
+			return err
+		}
+
+		movie, err = q.CreateMovie(ctx, storage.CreateMovieParams{
+			Title:      input.Title,
+			Year:       input.Year,
+			RuntimeMin: input.RuntimeMin,
+			Genres:     genres,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordRevision(ctx, q, RevisionCreate, &movie, createdFields())
 	})
 	if err != nil {
 		return nil, err
@@ -40,17 +81,145 @@ func (s *MovieService) CreateMovie(ctx context.Context, input MovieInput) (*Movi
 	return transform(&movie), nil
 }
 
-func (s *MovieService) ListMovies(ctx context.Context) ([]*Movie, error) {
-	movies, err := s.storage.ListMovies(ctx)
+func (s *MovieService) ListMovies(ctx context.Context, filters MovieFilters) ([]*Movie, Metadata, error) {
+	filters = filters.WithDefaults()
+	if err := filters.OK(); err != nil {
+		return nil, Metadata{}, err
+	}
+	filters, err := s.canonicalFilters(ctx, filters)
 	if err != nil {
-		return nil, err
+		return nil, Metadata{}, err
+	}
+
+	rows, err := s.storage.ListMovies(ctx, storage.ListMoviesParams{
+		Title:      likeEscaper.Replace(filters.Title),
+		Genres:     filters.Genres,
+		YearMin:    filters.YearMin,
+		YearMax:    filters.YearMax,
+		RuntimeMin: filters.RuntimeMin,
+		RuntimeMax: filters.RuntimeMax,
+		PersonID:   filters.PersonID,
+		Sort:       filters.Sort,
+		PageSize:   filters.PageSize,
+		PageOffset: filters.offset(),
+	})
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	var totalRecords int64
+	response := make([]*Movie, len(rows))
+	for i, row := range rows {
+		totalRecords = row.TotalRecords
+		response[i] = transform(&row.Movie)
+	}
+	return response, calculateMetadata(totalRecords, filters.Page, filters.PageSize), nil
+}
+
+// ListMoviesKeyset lists movies after the position encoded in cursor, or from
+// the start when cursor is empty. The returned metadata carries the cursor
+// for the next page, which is empty once the last page has been reached.
+func (s *MovieService) ListMoviesKeyset(ctx context.Context, filters MovieFilters, cursor string) ([]*Movie, Metadata, error) {
+	v := validator.New()
+	v.Check(filters.Page == 0, "page", "must not be used with keyset pagination")
+	if err := v.OK(); err != nil {
+		return nil, Metadata{}, err
+	}
+
+	filters = filters.WithDefaults()
+	if err := filters.OK(); err != nil {
+		return nil, Metadata{}, err
+	}
+	filters, err := s.canonicalFilters(ctx, filters)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	params := storage.ListMoviesKeysetParams{
+		Title:      likeEscaper.Replace(filters.Title),
+		Genres:     filters.Genres,
+		YearMin:    filters.YearMin,
+		YearMax:    filters.YearMax,
+		RuntimeMin: filters.RuntimeMin,
+		RuntimeMax: filters.RuntimeMax,
+		PersonID:   filters.PersonID,
+		Sort:       filters.Sort,
+		// Fetch one extra row to find out whether there is a next page.
+		PageSize: filters.PageSize + 1,
+	}
+
+	if cursor != "" {
+		after, err := s.cursors.decode(cursor, &filters)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+
+		params.AfterID = after.ID
+		params.AfterTitle = after.Title
+		params.AfterYear = after.Year
+		params.AfterRuntime = after.Runtime
+	}
+
+	movies, err := s.storage.ListMoviesKeyset(ctx, params)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	hasMore := len(movies) > int(filters.PageSize)
+	if hasMore {
+		movies = movies[:filters.PageSize]
 	}
 
 	response := make([]*Movie, len(movies))
 	for i, movie := range movies {
 		response[i] = transform(&movie)
 	}
-	return response, nil
+
+	metadata := Metadata{PageSize: filters.PageSize}
+	if hasMore {
+		metadata.NextCursor, err = s.cursors.encode(newMovieCursor(response[len(response)-1], &filters))
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+	}
+
+	return response, metadata, nil
+}
+
+// SearchMovies finds movies whose title matches the query, tolerating partial
+// words and misspellings. Results are ordered by relevance.
+func (s *MovieService) SearchMovies(ctx context.Context, query string, limit int32) ([]*SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+
+	v := validator.New()
+	v.Check(query != "", "q", errMustBeProvided)
+	v.Check(len(query) <= searchQueryMaxLength, "q", "must not be more than 200 bytes long")
+	v.Check(limit > 0, "limit", "must be greater than zero")
+	v.Check(limit <= searchLimitMax, "limit", "must be a maximum of 100")
+	if err := v.OK(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.storage.SearchMovies(ctx, storage.SearchMoviesParams{
+		Query:    query,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = &SearchResult{
+			Movie:   transform(&row.Movie),
+			Score:   row.Rank,
+			Snippet: row.Snippet,
+		}
+	}
+	return results, nil
 }
 
 func (s *MovieService) GetMovie(ctx context.Context, id int64) (*Movie, error) {
@@ -66,35 +235,122 @@ func (s *MovieService) GetMovie(ctx context.Context, id int64) (*Movie, error) {
 	return transform(&movie), nil
 }
 
+// UpdateMovie applies the updates to the movie. The write only succeeds if
+// the movie still has the version it was read at (or updates.ExpectedVersion
+// when set), otherwise ErrEditConflict is returned.
 func (s *MovieService) UpdateMovie(ctx context.Context, id int64, updates PartialMovieUpdate) (*Movie, error) {
-	movie, err := s.storage.GetMovie(ctx, id)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrMovieNotFound
+	var updated storage.Movie
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		movie, err := q.GetMovie(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrMovieNotFound
+			}
+
+			return err
 		}
 
-		return nil, err
-	}
+		if updates.ExpectedVersion != nil && *updates.ExpectedVersion != movie.Version {
+			return ErrEditConflict
+		}
+
+		fullUpdate := mergeMovieUpdates(&movie, &updates)
+		if err := fullUpdate.OK(); err != nil {
+			return err
+		}
 
-	fullUpdate := mergeMovieUpdates(&movie, &updates)
-	if err := fullUpdate.OK(); err != nil {
+		if updates.Genres != nil {
+			fullUpdate.Genres, err = canonicalGenres(ctx, q, fullUpdate.Genres)
+			if err != nil {
+				return err
+			}
+		}
+
+		updated, err = q.UpdateMovie(ctx, storage.UpdateMovieParams{
+			ID:         id,
+			Title:      fullUpdate.Title,
+			Year:       fullUpdate.Year,
+			RuntimeMin: fullUpdate.RuntimeMin,
+			Genres:     fullUpdate.Genres,
+			Version:    movie.Version,
+		})
+		if err != nil {
+			// The movie existed a moment ago, so no rows means someone else
+			// updated or deleted it in the meantime.
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrEditConflict
+			}
+
+			return err
+		}
+
+		return recordRevision(ctx, q, RevisionUpdate, &updated, changedFields(&movie, &updated))
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	updated, err := s.storage.UpdateMovie(ctx, storage.UpdateMovieParams{
-		ID:         id,
-		Title:      fullUpdate.Title,
-		Year:       fullUpdate.Year,
-		RuntimeMin: fullUpdate.RuntimeMin,
-		Genres:     fullUpdate.Genres,
+	return transform(&updated), nil
+}
+
+// DeleteMovie moves the movie to the trash. Deleted movies are hidden from
+// GetMovie and ListMovies until they are restored or purged.
+func (s *MovieService) DeleteMovie(ctx context.Context, id int64) error {
+	return s.storage.InTx(ctx, func(q storage.Querier) error {
+		movie, err := q.DeleteMovie(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrMovieNotFound
+			}
+
+			return err
+		}
+
+		return recordRevision(ctx, q, RevisionDelete, &movie, []string{fieldDeletedAt})
 	})
+}
+
+func (s *MovieService) ListDeletedMovies(ctx context.Context) ([]*Movie, error) {
+	movies, err := s.storage.ListDeletedMovies(ctx)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrMovieNotFound
+		return nil, err
+	}
+
+	response := make([]*Movie, len(movies))
+	for i, movie := range movies {
+		response[i] = transform(&movie)
+	}
+	return response, nil
+}
+
+// RestoreMovie takes the movie out of the trash.
+func (s *MovieService) RestoreMovie(ctx context.Context, id int64) (*Movie, error) {
+	var movie storage.Movie
+	err := s.storage.InTx(ctx, func(q storage.Querier) error {
+		var err error
+		movie, err = q.RestoreMovie(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrMovieNotFound
+			}
+
+			return err
 		}
 
+		return recordRevision(ctx, q, RevisionRestore, &movie, []string{fieldDeletedAt})
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return transform(&updated), nil
+	return transform(&movie), nil
+}
+
+// PurgeDeletedMovies permanently removes movies that have been in the trash
+// for longer than the retention period and returns how many were removed.
+func (s *MovieService) PurgeDeletedMovies(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.storage.PurgeDeletedMovies(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(-retention),
+		Valid: true,
+	})
 }
@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/testcontainers/testcontainers-go"
 	"github.com/zbsss/greenlight/movies/backend/storage"
 	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/movies/backend/storage/teststorage"
 	"github.com/zbsss/greenlight/pkg/validator"
 	"k8s.io/utils/ptr"
 )
@@ -30,6 +36,26 @@ func setupTest(t *testing.T) testHelpers {
 	return testHelpers{t: t, model: mockModel, service: service}
 }
 
+// setupTestStorage starts a Postgres container seeded with test data, skipping
+// the test when Docker is not available.
+func setupTestStorage(t *testing.T) *teststorage.TestStorage {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ts, err := teststorage.New(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := ts.Close(context.Background()); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return ts
+}
+
 func (h testHelpers) assertError(expected, actual error) {
 	h.t.Helper()
 
@@ -195,6 +221,34 @@ func TestUpdateMovie(t *testing.T) {
 			input:         PartialMovieUpdate{},
 			expectedMovie: cloneWithOverrides(nil), // Use base expected without modifications
 		},
+		{
+			name: "expected version matches",
+			id:   1,
+			input: PartialMovieUpdate{
+				Title:           ptr.To("Django Unchained"),
+				ExpectedVersion: ptr.To[int32](1),
+			},
+			expectedMovie: cloneWithOverrides(func(m *Movie) {
+				m.Title = "Django Unchained"
+			}),
+		},
+		{
+			name: "expected version is stale",
+			id:   1,
+			input: PartialMovieUpdate{
+				Title:           ptr.To("Django Unchained"),
+				ExpectedVersion: ptr.To[int32](0),
+			},
+			expectedError: ErrEditConflict,
+		},
+		{
+			name: "invalid update",
+			id:   1,
+			input: PartialMovieUpdate{
+				Year: ptr.To[int32](1000),
+			},
+			expectedError: validator.ValidationError{},
+		},
 		{
 			name:          "not found",
 			id:            2,
@@ -210,7 +264,7 @@ func TestUpdateMovie(t *testing.T) {
 	}
 
 	for _, tc := range tcs {
-		t.Run(tc.name, func(_ *testing.T) {
+		t.Run(tc.name, func(t *testing.T) {
 			h.model.Reset(existingMovie)
 			if tc.injectDBError != nil {
 				h.model.FailOnNextCall(tc.injectDBError)
@@ -219,6 +273,525 @@ func TestUpdateMovie(t *testing.T) {
 			actualMovie, err := h.service.UpdateMovie(context.Background(), tc.id, tc.input)
 			h.assertError(tc.expectedError, err)
 			h.assertMovie(tc.expectedMovie, actualMovie)
+
+			// The read and the write happen in one transaction, which is
+			// only committed when the update succeeds.
+			expectedCommits, expectedRollbacks := 1, 0
+			if tc.expectedError != nil {
+				expectedCommits, expectedRollbacks = 0, 1
+			}
+			if h.model.Commits() != expectedCommits || h.model.Rollbacks() != expectedRollbacks {
+				t.Fatalf("expected %d commits and %d rollbacks; got %d and %d",
+					expectedCommits, expectedRollbacks, h.model.Commits(), h.model.Rollbacks())
+			}
+		})
+	}
+}
+
+func TestDeleteMovie(t *testing.T) {
+	h := setupTest(t)
+
+	existingMovie := storage.Movie{
+		ID:         1,
+		Version:    1,
+		Title:      "Django",
+		Year:       2017,
+		RuntimeMin: 120,
+		Genres:     []string{"action"},
+	}
+
+	tcs := []struct {
+		name          string
+		id            int64
+		injectDBError error
+		expectedError error
+	}{
+		{
+			name: "ok",
+			id:   1,
+		},
+		{
+			name:          "not found",
+			id:            2,
+			expectedError: ErrMovieNotFound,
+		},
+		{
+			name:          "db error",
+			id:            1,
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(_ *testing.T) {
+			h.model.Reset(existingMovie)
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			err := h.service.DeleteMovie(context.Background(), tc.id)
+			h.assertError(tc.expectedError, err)
+			if err != nil {
+				return
+			}
+
+			if _, err := h.service.GetMovie(context.Background(), tc.id); !errors.Is(err, ErrMovieNotFound) {
+				h.t.Fatalf("expected deleted movie to be hidden; got %v", err)
+			}
+
+			trash, err := h.service.ListDeletedMovies(context.Background())
+			h.assertError(nil, err)
+			if len(trash) != 1 || trash[0].ID != tc.id || trash[0].DeletedAt == nil {
+				h.t.Fatalf("expected movie %d in the trash; got %v", tc.id, trash)
+			}
+		})
+	}
+}
+
+func TestRestoreMovie(t *testing.T) {
+	h := setupTest(t)
+
+	deletedMovie := storage.Movie{
+		ID:         1,
+		Version:    1,
+		Title:      "Django",
+		Year:       2017,
+		RuntimeMin: 120,
+		Genres:     []string{"action"},
+		DeletedAt:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	activeMovie := storage.Movie{
+		ID:         2,
+		Version:    1,
+		Title:      "Casablanca",
+		Year:       1942,
+		RuntimeMin: 102,
+		Genres:     []string{"drama"},
+	}
+
+	tcs := []struct {
+		name          string
+		id            int64
+		injectDBError error
+		expectedMovie *Movie
+		expectedError error
+	}{
+		{
+			name: "ok",
+			id:   1,
+			expectedMovie: &Movie{
+				ID:         1,
+				Version:    1,
+				Title:      "Django",
+				Year:       2017,
+				RuntimeMin: 120,
+				Genres:     []string{"action"},
+			},
+		},
+		{
+			name:          "not in trash",
+			id:            2,
+			expectedError: ErrMovieNotFound,
+		},
+		{
+			name:          "not found",
+			id:            3,
+			expectedError: ErrMovieNotFound,
+		},
+		{
+			name:          "db error",
+			id:            1,
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(_ *testing.T) {
+			h.model.Reset(deletedMovie, activeMovie)
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			actualMovie, err := h.service.RestoreMovie(context.Background(), tc.id)
+			h.assertError(tc.expectedError, err)
+			h.assertMovie(tc.expectedMovie, actualMovie)
+		})
+	}
+}
+
+func TestPurgeDeletedMovies(t *testing.T) {
+	h := setupTest(t)
+
+	recentlyDeleted := storage.Movie{
+		ID:        1,
+		DeletedAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+	}
+	longDeleted := storage.Movie{
+		ID:        2,
+		DeletedAt: pgtype.Timestamptz{Time: time.Now().Add(-48 * time.Hour), Valid: true},
+	}
+	active := storage.Movie{ID: 3}
+
+	h.model.Reset(recentlyDeleted, longDeleted, active)
+
+	purged, err := h.service.PurgeDeletedMovies(context.Background(), 24*time.Hour)
+	h.assertError(nil, err)
+	if purged != 1 {
+		t.Fatalf("expected 1 movie to be purged; got %d", purged)
+	}
+
+	trash, err := h.service.ListDeletedMovies(context.Background())
+	h.assertError(nil, err)
+	if len(trash) != 1 || trash[0].ID != recentlyDeleted.ID {
+		t.Fatalf("expected only movie %d to remain in the trash; got %v", recentlyDeleted.ID, trash)
+	}
+}
+
+func TestListMovies(t *testing.T) {
+	h := setupTest(t)
+
+	existingMovies := []storage.Movie{
+		{ID: 1, Title: "The Godfather", Year: 1972, RuntimeMin: 175, Genres: []string{"crime", "drama"}},
+		{ID: 2, Title: "Pulp Fiction", Year: 1994, RuntimeMin: 154, Genres: []string{"crime", "drama"}},
+		{ID: 3, Title: "The Dark Knight", Year: 2008, RuntimeMin: 152, Genres: []string{"action", "crime"}},
+		{ID: 4, Title: "Fight Club", Year: 1999, RuntimeMin: 139, Genres: []string{"drama"}},
+		{ID: 5, Title: "Toy Story", Year: 1995, RuntimeMin: 81, Genres: []string{"animation"}},
+	}
+
+	tcs := []struct {
+		name             string
+		filters          MovieFilters
+		injectDBError    error
+		expectedIDs      []int64
+		expectedMetadata Metadata
+		expectedError    error
+	}{
+		{
+			name:             "defaults",
+			filters:          MovieFilters{},
+			expectedIDs:      []int64{1, 2, 3, 4, 5},
+			expectedMetadata: Metadata{CurrentPage: 1, PageSize: 20, FirstPage: 1, LastPage: 1, TotalRecords: 5},
+		},
+		{
+			name:             "title substring",
+			filters:          MovieFilters{Title: "the"},
+			expectedIDs:      []int64{1, 3},
+			expectedMetadata: Metadata{CurrentPage: 1, PageSize: 20, FirstPage: 1, LastPage: 1, TotalRecords: 2},
+		},
+		{
+			name:             "genres containment",
+			filters:          MovieFilters{Genres: []string{"crime", "drama"}},
+			expectedIDs:      []int64{1, 2},
+			expectedMetadata: Metadata{CurrentPage: 1, PageSize: 20, FirstPage: 1, LastPage: 1, TotalRecords: 2},
+		},
+		{
+			name:             "year and runtime range",
+			filters:          MovieFilters{YearMin: 1990, YearMax: 2000, RuntimeMin: 100, RuntimeMax: 150},
+			expectedIDs:      []int64{4},
+			expectedMetadata: Metadata{CurrentPage: 1, PageSize: 20, FirstPage: 1, LastPage: 1, TotalRecords: 1},
+		},
+		{
+			name:             "sort descending by year",
+			filters:          MovieFilters{Sort: "-year"},
+			expectedIDs:      []int64{3, 4, 5, 2, 1},
+			expectedMetadata: Metadata{CurrentPage: 1, PageSize: 20, FirstPage: 1, LastPage: 1, TotalRecords: 5},
+		},
+		{
+			name:             "second page",
+			filters:          MovieFilters{Sort: "title", Page: 2, PageSize: 2},
+			expectedIDs:      []int64{3, 1},
+			expectedMetadata: Metadata{CurrentPage: 2, PageSize: 2, FirstPage: 1, LastPage: 3, TotalRecords: 5},
+		},
+		{
+			name:          "invalid sort",
+			filters:       MovieFilters{Sort: "created_at"},
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "page size too large",
+			filters:       MovieFilters{PageSize: 101},
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "db error",
+			filters:       MovieFilters{},
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(_ *testing.T) {
+			h.model.Reset(existingMovies...)
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			movies, metadata, err := h.service.ListMovies(context.Background(), tc.filters)
+			h.assertError(tc.expectedError, err)
+
+			actualIDs := make([]int64, len(movies))
+			for i, m := range movies {
+				actualIDs[i] = m.ID
+			}
+
+			if !cmp.Equal(tc.expectedIDs, actualIDs, cmpopts.EquateEmpty()) {
+				h.t.Fatalf("expected movie IDs %v; got %v", tc.expectedIDs, actualIDs)
+			}
+			if !cmp.Equal(tc.expectedMetadata, metadata) {
+				h.t.Fatalf("expected metadata %+v; got %+v", tc.expectedMetadata, metadata)
+			}
+		})
+	}
+}
+
+func TestListMoviesTitleWildcards(t *testing.T) {
+	h := setupTest(t)
+	h.model.Reset(
+		storage.Movie{ID: 1, Title: "100% Wolf", Year: 2020, RuntimeMin: 96, Genres: []string{"animation"}},
+		storage.Movie{ID: 2, Title: "100 Wolves", Year: 2021, RuntimeMin: 90, Genres: []string{"drama"}},
+		storage.Movie{ID: 3, Title: `Back\Slash`, Year: 2022, RuntimeMin: 90, Genres: []string{"drama"}},
+	)
+
+	tcs := []struct {
+		title       string
+		expectedIDs []int64
+	}{
+		{title: "100%", expectedIDs: []int64{1}},
+		{title: "% Wolf", expectedIDs: []int64{1}},
+		{title: "_", expectedIDs: nil},
+		{title: `\`, expectedIDs: []int64{3}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.title, func(t *testing.T) {
+			movies, _, err := h.service.ListMovies(context.Background(), MovieFilters{Title: tc.title})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var actualIDs []int64
+			for _, m := range movies {
+				actualIDs = append(actualIDs, m.ID)
+			}
+			if !cmp.Equal(tc.expectedIDs, actualIDs, cmpopts.EquateEmpty()) {
+				t.Errorf("expected IDs %v; got %v", tc.expectedIDs, actualIDs)
+			}
+		})
+	}
+}
+
+func TestListMoviesTitleWildcardsPostgres(t *testing.T) {
+	ctx := context.Background()
+	s := New(setupTestStorage(t))
+
+	movie, err := s.CreateMovie(ctx, MovieInput{Title: "100% Wolf", Year: 2020, RuntimeMin: 96, Genres: []string{"animation"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	movies, _, err := s.ListMovies(ctx, MovieFilters{Title: "100%"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].ID != movie.ID {
+		t.Errorf("expected only %q to match; got %v", movie.Title, movies)
+	}
+
+	movies, _, err = s.ListMovies(ctx, MovieFilters{Title: "_"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 0 {
+		t.Errorf("expected no title to contain an underscore; got %v", movies)
+	}
+}
+
+func TestListMoviesKeyset(t *testing.T) {
+	h := setupTest(t)
+
+	existingMovies := []storage.Movie{
+		{ID: 1, Title: "The Godfather", Year: 1972, RuntimeMin: 175, Genres: []string{"crime", "drama"}},
+		{ID: 2, Title: "Pulp Fiction", Year: 1994, RuntimeMin: 154, Genres: []string{"crime", "drama"}},
+		{ID: 3, Title: "The Dark Knight", Year: 2008, RuntimeMin: 152, Genres: []string{"action", "crime"}},
+		{ID: 4, Title: "Fight Club", Year: 1999, RuntimeMin: 139, Genres: []string{"drama"}},
+		{ID: 5, Title: "Toy Story", Year: 1995, RuntimeMin: 81, Genres: []string{"animation"}},
+		{ID: 6, Title: "Forrest Gump", Year: 1994, RuntimeMin: 142, Genres: []string{"drama"}},
+	}
+
+	expectedOrder := map[string][]int64{
+		"id":       {1, 2, 3, 4, 5, 6},
+		"-id":      {6, 5, 4, 3, 2, 1},
+		"title":    {4, 6, 2, 3, 1, 5},
+		"-title":   {5, 1, 3, 2, 6, 4},
+		"year":     {1, 2, 6, 5, 4, 3},
+		"-year":    {3, 4, 5, 6, 2, 1},
+		"runtime":  {5, 4, 6, 3, 2, 1},
+		"-runtime": {1, 2, 3, 6, 4, 5},
+	}
+
+	for sort, expectedIDs := range expectedOrder {
+		t.Run(sort, func(t *testing.T) {
+			h.model.Reset(existingMovies...)
+
+			var (
+				actualIDs []int64
+				cursor    string
+			)
+			for range len(existingMovies) {
+				movies, metadata, err := h.service.ListMoviesKeyset(context.Background(), MovieFilters{Sort: sort, PageSize: 4}, cursor)
+				h.assertError(nil, err)
+
+				for _, m := range movies {
+					actualIDs = append(actualIDs, m.ID)
+				}
+
+				cursor = metadata.NextCursor
+				if cursor == "" {
+					break
+				}
+			}
+
+			if !cmp.Equal(expectedIDs, actualIDs) {
+				t.Fatalf("expected movie IDs %v; got %v", expectedIDs, actualIDs)
+			}
+		})
+	}
+
+	t.Run("invalid cursors", func(t *testing.T) {
+		h.model.Reset(existingMovies...)
+
+		filters := MovieFilters{Sort: "title", PageSize: 2}
+		_, metadata, err := h.service.ListMoviesKeyset(context.Background(), filters, "")
+		h.assertError(nil, err)
+
+		// Swap the first character of the payload for a different one.
+		payload, sig, _ := strings.Cut(metadata.NextCursor, ".")
+		replacement := "A"
+		if payload[0] == 'A' {
+			replacement = "B"
+		}
+		tampered := replacement + payload[1:] + "." + sig
+
+		tcs := []struct {
+			name    string
+			filters MovieFilters
+			cursor  string
+		}{
+			{name: "malformed", filters: filters, cursor: "not-a-cursor"},
+			{name: "tampered", filters: filters, cursor: tampered},
+			{name: "different sort", filters: MovieFilters{Sort: "year", PageSize: 2}, cursor: metadata.NextCursor},
+			{name: "different filters", filters: MovieFilters{Sort: "title", Title: "the", PageSize: 2}, cursor: metadata.NextCursor},
+			{
+				name:    "signed with another key",
+				filters: filters,
+				cursor: func() string {
+					other := New(h.model, WithCursorSecret([]byte("another secret")))
+					_, md, err := other.ListMoviesKeyset(context.Background(), filters, "")
+					h.assertError(nil, err)
+					return md.NextCursor
+				}(),
+			},
+		}
+
+		for _, tc := range tcs {
+			_, _, err := h.service.ListMoviesKeyset(context.Background(), tc.filters, tc.cursor)
+			if !errors.Is(err, ErrInvalidCursor) {
+				t.Fatalf("%s: expected error to be %v; got %v", tc.name, ErrInvalidCursor, err)
+			}
+		}
+	})
+
+	t.Run("page is rejected", func(_ *testing.T) {
+		_, _, err := h.service.ListMoviesKeyset(context.Background(), MovieFilters{Page: 2}, "")
+		h.assertError(validator.ValidationError{}, err)
+	})
+}
+
+func TestSearchMovies(t *testing.T) {
+	h := setupTest(t)
+
+	existingMovies := []storage.Movie{
+		{ID: 1, Title: "The Godfather", Year: 1972, RuntimeMin: 175, Genres: []string{"crime", "drama"}},
+		{ID: 2, Title: "The Godfather Part II", Year: 1974, RuntimeMin: 202, Genres: []string{"crime", "drama"}},
+		{ID: 3, Title: "Pulp Fiction", Year: 1994, RuntimeMin: 154, Genres: []string{"crime", "drama"}},
+		{ID: 4, Title: "Tom & Jerry <3", Year: 1992, RuntimeMin: 84, Genres: []string{"animation"}},
+	}
+
+	tcs := []struct {
+		name             string
+		query            string
+		limit            int32
+		injectDBError    error
+		expectedIDs      []int64
+		expectedSnippets []string
+		expectedError    error
+	}{
+		{
+			name:             "ranked matches",
+			query:            "godfather",
+			expectedIDs:      []int64{1, 2},
+			expectedSnippets: []string{"The <mark>Godfather</mark>", "The <mark>Godfather</mark> Part II"},
+		},
+		{
+			name:             "limit",
+			query:            "godfather",
+			limit:            1,
+			expectedIDs:      []int64{1},
+			expectedSnippets: []string{"The <mark>Godfather</mark>"},
+		},
+		{
+			name:             "escaped title",
+			query:            "jerry",
+			expectedIDs:      []int64{4},
+			expectedSnippets: []string{"Tom &amp; <mark>Jerry</mark> &lt;3"},
+		},
+		{
+			name:          "empty query",
+			query:         "  ",
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "limit too large",
+			query:         "godfather",
+			limit:         101,
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "db error",
+			query:         "godfather",
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(_ *testing.T) {
+			h.model.Reset(existingMovies...)
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			results, err := h.service.SearchMovies(context.Background(), tc.query, tc.limit)
+			h.assertError(tc.expectedError, err)
+
+			var (
+				actualIDs      []int64
+				actualSnippets []string
+			)
+			for _, r := range results {
+				actualIDs = append(actualIDs, r.Movie.ID)
+				actualSnippets = append(actualSnippets, r.Snippet)
+			}
+
+			if !cmp.Equal(tc.expectedIDs, actualIDs) {
+				h.t.Fatalf("expected movie IDs %v; got %v", tc.expectedIDs, actualIDs)
+			}
+			if !cmp.Equal(tc.expectedSnippets, actualSnippets) {
+				h.t.Fatalf("expected snippets %v; got %v", tc.expectedSnippets, actualSnippets)
+			}
 		})
 	}
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+)
+
+const (
+	ScopeActivation     = "activation"
+	ScopeAuthentication = "authentication"
+
+	activationTokenTTL     = 3 * 24 * time.Hour
+	authenticationTokenTTL = 24 * time.Hour
+
+	// tokenLength is the length of the plaintext produced by generateToken.
+	tokenLength = 26
+)
+
+// Token is a random secret handed to a user. Only its hash is stored, so the
+// plaintext is available just once, when the token is generated.
+type Token struct {
+	Plaintext string
+	Hash      []byte
+	UserID    int64
+	Expiry    time.Time
+	Scope     string
+}
+
+func generateToken(userID int64, ttl time.Duration, scope string) Token {
+	// rand.Text returns a 26 character base32 string with 128 bits of entropy.
+	plaintext := rand.Text()
+
+	return Token{
+		Plaintext: plaintext,
+		Hash:      hashToken(plaintext),
+		UserID:    userID,
+		Expiry:    time.Now().Add(ttl),
+		Scope:     scope,
+	}
+}
+
+func hashToken(plaintext string) []byte {
+	hash := sha256.Sum256([]byte(plaintext))
+	return hash[:]
+}
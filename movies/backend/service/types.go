@@ -1,6 +1,8 @@
 package service
 
 import (
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/zbsss/greenlight/movies/backend/storage"
@@ -11,6 +13,10 @@ const (
 	titleMaxLength = 500
 	yearMin        = 1888
 	genresMaxCount = 5
+
+	searchQueryMaxLength = 200
+	searchLimitMax       = 100
+	DefaultSearchLimit   = 20
 )
 
 type Movie struct {
@@ -20,6 +26,16 @@ type Movie struct {
 	RuntimeMin int32
 	Genres     []string
 	Version    int32
+	DeletedAt  *time.Time
+}
+
+// SearchResult is a movie matching a search query, with its relevance score
+// and the title with the matching words wrapped in <mark> tags. The snippet
+// is HTML, the title in it is escaped.
+type SearchResult struct {
+	Movie   *Movie
+	Score   float32
+	Snippet string
 }
 
 type MovieInput struct {
@@ -34,12 +50,49 @@ type PartialMovieUpdate struct {
 	Year       *int32
 	RuntimeMin *int32
 	Genres     []string
+
+	// ExpectedVersion is the version the client last saw. When set, the
+	// update is rejected if the movie has been changed since.
+	ExpectedVersion *int32
 }
 
 const (
 	errMustBeProvided = "must be provided"
+
+	nameMaxLength     = 500
+	passwordMinLength = 8
+	passwordMaxLength = 72
 )
 
+type User struct {
+	ID        int64
+	CreatedAt time.Time
+	Name      string
+	Email     string
+	Activated bool
+	Version   int32
+	// Permissions is only loaded for authenticated users.
+	Permissions []string
+}
+
+func (u *User) IsActivated() bool {
+	return u.Activated
+}
+
+func (u *User) HasPermission(code string) bool {
+	return slices.Contains(u.Permissions, code)
+}
+
+func (u *User) Subject() string {
+	return strconv.FormatInt(u.ID, 10)
+}
+
+type UserInput struct {
+	Name     string
+	Email    string
+	Password string
+}
+
 func (m MovieInput) OK() error {
 	v := validator.New()
 
@@ -61,6 +114,23 @@ func (m MovieInput) OK() error {
 	return v.OK()
 }
 
+func (u UserInput) OK() error {
+	v := validator.New()
+
+	v.Check(u.Name != "", "name", errMustBeProvided)
+	v.Check(len(u.Name) <= nameMaxLength, "name", "must not be more than 500 bytes long")
+
+	v.Check(u.Email != "", "email", errMustBeProvided)
+	v.Check(validator.Matches(u.Email, validator.EmailRX), "email", "must be a valid email address")
+
+	v.Check(u.Password != "", "password", errMustBeProvided)
+	v.Check(len(u.Password) >= passwordMinLength, "password", "must be at least 8 bytes long")
+	// bcrypt ignores everything after the first 72 bytes.
+	v.Check(len(u.Password) <= passwordMaxLength, "password", "must not be more than 72 bytes long")
+
+	return v.OK()
+}
+
 func mergeMovieUpdates(existing *storage.Movie, updates *PartialMovieUpdate) MovieInput {
 	result := MovieInput{
 		Title:      existing.Title,
@@ -89,7 +159,7 @@ func mergeMovieUpdates(existing *storage.Movie, updates *PartialMovieUpdate) Mov
 }
 
 func transform(movie *storage.Movie) *Movie {
-	return &Movie{
+	m := &Movie{
 		ID:         movie.ID,
 		Title:      movie.Title,
 		Year:       movie.Year,
@@ -97,4 +167,21 @@ func transform(movie *storage.Movie) *Movie {
 		Genres:     movie.Genres,
 		Version:    movie.Version,
 	}
+
+	if movie.DeletedAt.Valid {
+		m.DeletedAt = &movie.DeletedAt.Time
+	}
+
+	return m
+}
+
+func transformUser(user *storage.User) *User {
+	return &User{
+		ID:        user.ID,
+		CreatedAt: user.CreatedAt.Time,
+		Name:      user.Name,
+		Email:     user.Email,
+		Activated: user.Activated,
+		Version:   user.Version,
+	}
 }
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/pkg/validator"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	bcryptCost = 12
+
+	// uniqueViolation is the Postgres error code returned for duplicate keys.
+	uniqueViolation = "23505"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid authentication credentials")
+	ErrInvalidToken       = errors.New("invalid or expired token")
+)
+
+// dummyPasswordHash is compared against when no user has the given email, so
+// that unknown and known emails take about as long to reject.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("not a real password"), bcryptCost)
+
+// Notifier delivers tokens to users out of band, e.g. by email.
+type Notifier interface {
+	SendActivationToken(ctx context.Context, user *User, token string) error
+}
+
+type UserService struct {
+	storage  storage.Store
+	notifier Notifier
+}
+
+func NewUserService(s storage.Store, n Notifier) *UserService {
+	return &UserService{storage: s, notifier: n}
+}
+
+// RegisterUser creates a new, not yet activated user and sends them a single
+// use activation token.
+func (s *UserService) RegisterUser(ctx context.Context, input UserInput) (*User, error) {
+	if err := input.OK(); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcryptCost)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		registered *User
+		activation Token
+	)
+	err = s.storage.InTx(ctx, func(q storage.Querier) error {
+		user, err := q.CreateUser(ctx, storage.CreateUserParams{
+			Name:         input.Name,
+			Email:        input.Email,
+			PasswordHash: passwordHash,
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "users_email_key" {
+				v := validator.New()
+				v.AddError("email", "a user with this email address already exists")
+				return v.OK()
+			}
+
+			return err
+		}
+
+		activation = generateToken(user.ID, activationTokenTTL, ScopeActivation)
+		if err := storeToken(ctx, q, &activation); err != nil {
+			return err
+		}
+
+		registered = transformUser(&user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The token is only sent once the user has been committed, since the
+	// transaction may be retried. A user whose token could not be delivered
+	// could never be activated, so it is deleted to free up the email.
+	if err := s.notifier.SendActivationToken(ctx, registered, activation.Plaintext); err != nil {
+		if deleteErr := s.storage.DeleteUser(context.WithoutCancel(ctx), registered.ID); deleteErr != nil {
+			return nil, errors.Join(err, deleteErr)
+		}
+		return nil, err
+	}
+
+	return registered, nil
+}
+
+// ActivateUser activates the user the token was issued to. The token, and any
+// other activation tokens of the user, can not be used again.
+func (s *UserService) ActivateUser(ctx context.Context, tokenPlaintext string) (*User, error) {
+	v := validator.New()
+	v.Check(tokenPlaintext != "", "token", errMustBeProvided)
+	v.Check(len(tokenPlaintext) == tokenLength, "token", "must be 26 bytes long")
+	if err := v.OK(); err != nil {
+		return nil, err
+	}
+
+	row, err := s.storage.GetUserForToken(ctx, storage.GetUserForTokenParams{
+		Hash:  hashToken(tokenPlaintext),
+		Scope: ScopeActivation,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			v.AddError("token", "invalid or expired activation token")
+			return nil, v.OK()
+		}
+
+		return nil, err
+	}
+
+	user, err := s.storage.ActivateUser(ctx, storage.ActivateUserParams{
+		ID:      row.User.ID,
+		Version: row.User.Version,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEditConflict
+		}
+
+		return nil, err
+	}
+
+	err = s.storage.DeleteTokensForUser(ctx, storage.DeleteTokensForUserParams{
+		Scope:  ScopeActivation,
+		UserID: user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transformUser(&user), nil
+}
+
+// CreateAuthenticationToken checks the user's credentials and issues a new
+// authentication token for them.
+func (s *UserService) CreateAuthenticationToken(ctx context.Context, email, password string) (*Token, error) {
+	v := validator.New()
+	v.Check(email != "", "email", errMustBeProvided)
+	v.Check(password != "", "password", errMustBeProvided)
+	if err := v.OK(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.storage.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+			return nil, ErrInvalidCredentials
+		}
+
+		return nil, err
+	}
+
+	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return nil, ErrInvalidCredentials
+		}
+
+		return nil, err
+	}
+
+	token := generateToken(user.ID, authenticationTokenTTL, ScopeAuthentication)
+	if err := storeToken(ctx, s.storage, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// AuthenticateUser returns the user an unexpired authentication token was
+// issued to, or ErrInvalidToken.
+func (s *UserService) AuthenticateUser(ctx context.Context, tokenPlaintext string) (*User, error) {
+	if len(tokenPlaintext) != tokenLength {
+		return nil, ErrInvalidToken
+	}
+
+	row, err := s.storage.GetUserForToken(ctx, storage.GetUserForTokenParams{
+		Hash:  hashToken(tokenPlaintext),
+		Scope: ScopeAuthentication,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidToken
+		}
+
+		return nil, err
+	}
+
+	user := transformUser(&row.User)
+
+	user.Permissions, err = s.permissionsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func storeToken(ctx context.Context, q storage.Querier, token *Token) error {
+	return q.CreateToken(ctx, storage.CreateTokenParams{
+		Hash:   token.Hash,
+		UserID: token.UserID,
+		Expiry: pgtype.Timestamptz{Time: token.Expiry, Valid: true},
+		Scope:  token.Scope,
+	})
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/validator"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRegisterUser(t *testing.T) {
+	h := setupTest(t)
+
+	validInput := UserInput{
+		Name:     "Alice Smith",
+		Email:    "alice@example.com",
+		Password: "pa55word",
+	}
+
+	tcs := []struct {
+		name          string
+		existing      []storage.User
+		input         UserInput
+		injectDBError error
+		expectedError error
+	}{
+		{
+			name:  "valid input",
+			input: validInput,
+		},
+		{
+			name:          "empty input",
+			input:         UserInput{},
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "invalid email",
+			input:         UserInput{Name: "Alice", Email: "alice", Password: "pa55word"},
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "password too long",
+			input:         UserInput{Name: "Alice", Email: "alice@example.com", Password: string(make([]byte, 73))},
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "duplicate email",
+			existing:      []storage.User{{ID: 1, Email: "ALICE@example.com"}},
+			input:         validInput,
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "db error",
+			input:         validInput,
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h.model.Reset()
+			h.model.AddUsers(tc.existing...)
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			notifier := &mocks.Notifier[*User]{}
+			us := NewUserService(h.model, notifier)
+
+			user, err := us.RegisterUser(context.Background(), tc.input)
+			h.assertError(tc.expectedError, err)
+			if tc.expectedError != nil {
+				return
+			}
+
+			if user.Activated {
+				t.Error("expected new user to not be activated")
+			}
+			if notifier.User == nil || notifier.User.ID != user.ID {
+				t.Fatalf("expected activation token to be sent to user %d", user.ID)
+			}
+			if len(notifier.Token) != tokenLength {
+				t.Errorf("expected token of length %d; got %q", tokenLength, notifier.Token)
+			}
+
+			stored, err := h.model.GetUser(context.Background(), user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := bcrypt.CompareHashAndPassword(stored.PasswordHash, []byte(tc.input.Password)); err != nil {
+				t.Errorf("expected stored password hash to match the password: %v", err)
+			}
+		})
+	}
+}
+
+func TestRegisterUserNotifierError(t *testing.T) {
+	h := setupTest(t)
+	input := UserInput{Name: "Alice Smith", Email: "alice@example.com", Password: "pa55word"}
+
+	errNotify := errors.New("mail server down")
+	_, err := NewUserService(h.model, &mocks.Notifier[*User]{Err: errNotify}).RegisterUser(context.Background(), input)
+	if !errors.Is(err, errNotify) {
+		t.Fatalf("expected %v; got %v", errNotify, err)
+	}
+
+	// The token is sent after the user was committed, the user is then
+	// deleted again.
+	if h.model.Commits() != 1 || h.model.Rollbacks() != 0 {
+		t.Errorf("expected the user to be committed before sending the token; got %d commits and %d rollbacks", h.model.Commits(), h.model.Rollbacks())
+	}
+	if _, err := h.model.GetUser(context.Background(), 1); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected the user to be deleted; got %v", err)
+	}
+
+	// Registering again with the same email works.
+	notifier := &mocks.Notifier[*User]{}
+	if _, err := NewUserService(h.model, notifier).RegisterUser(context.Background(), input); err != nil {
+		t.Fatal(err)
+	}
+	if notifier.Token == "" {
+		t.Error("expected the activation token to be sent")
+	}
+}
+
+func TestActivateUser(t *testing.T) {
+	h := setupTest(t)
+
+	user := storage.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 1}
+	valid := generateToken(user.ID, time.Hour, ScopeActivation)
+	expired := generateToken(user.ID, -time.Hour, ScopeActivation)
+	otherScope := generateToken(user.ID, time.Hour, "authentication")
+
+	toStorage := func(token Token) storage.Token {
+		return storage.Token{
+			Hash:   token.Hash,
+			UserID: token.UserID,
+			Expiry: pgtype.Timestamptz{Time: token.Expiry, Valid: true},
+			Scope:  token.Scope,
+		}
+	}
+
+	tcs := []struct {
+		name          string
+		token         string
+		injectDBError error
+		expectedError error
+	}{
+		{
+			name:  "valid token",
+			token: valid.Plaintext,
+		},
+		{
+			name:          "malformed token",
+			token:         "abc",
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "unknown token",
+			token:         generateToken(user.ID, time.Hour, ScopeActivation).Plaintext,
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "expired token",
+			token:         expired.Plaintext,
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "token with a different scope",
+			token:         otherScope.Plaintext,
+			expectedError: validator.ValidationError{},
+		},
+		{
+			name:          "db error",
+			token:         valid.Plaintext,
+			injectDBError: errInjectedDBError,
+			expectedError: errInjectedDBError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h.model.Reset()
+			h.model.AddUsers(user)
+			h.model.AddTokens(toStorage(valid), toStorage(expired), toStorage(otherScope))
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			us := NewUserService(h.model, &mocks.Notifier[*User]{})
+
+			activated, err := us.ActivateUser(context.Background(), tc.token)
+			h.assertError(tc.expectedError, err)
+			if tc.expectedError != nil {
+				return
+			}
+
+			if !activated.Activated {
+				t.Error("expected user to be activated")
+			}
+
+			// Activation tokens are single use.
+			_, err = us.ActivateUser(context.Background(), tc.token)
+			h.assertError(validator.ValidationError{}, err)
+		})
+	}
+}
+
+func TestCreateAuthenticationToken(t *testing.T) {
+	h := setupTest(t)
+	us := NewUserService(h.model, &mocks.Notifier[*User]{})
+
+	user, err := us.RegisterUser(context.Background(), UserInput{
+		Name:     "Alice Smith",
+		Email:    "alice@example.com",
+		Password: "pa55word",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		name          string
+		email         string
+		password      string
+		expectedError error
+	}{
+		{
+			name:     "valid credentials",
+			email:    "alice@example.com",
+			password: "pa55word",
+		},
+		{
+			name:          "wrong password",
+			email:         "alice@example.com",
+			password:      "wrong password",
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:          "unknown email",
+			email:         "bob@example.com",
+			password:      "pa55word",
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:          "missing password",
+			email:         "alice@example.com",
+			expectedError: validator.ValidationError{},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := us.CreateAuthenticationToken(context.Background(), tc.email, tc.password)
+			h.assertError(tc.expectedError, err)
+			if tc.expectedError != nil {
+				return
+			}
+
+			authenticated, err := us.AuthenticateUser(context.Background(), token.Plaintext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if authenticated.ID != user.ID {
+				t.Errorf("expected token to authenticate user %d; got %d", user.ID, authenticated.ID)
+			}
+		})
+	}
+}
+
+func TestAuthenticateUser(t *testing.T) {
+	h := setupTest(t)
+
+	user := storage.User{ID: 1, Name: "Alice", Email: "alice@example.com", Version: 1}
+	valid := generateToken(user.ID, time.Hour, ScopeAuthentication)
+	expired := generateToken(user.ID, -time.Hour, ScopeAuthentication)
+	activation := generateToken(user.ID, time.Hour, ScopeActivation)
+
+	h.model.Reset()
+	h.model.AddUsers(user)
+	for _, token := range []Token{valid, expired, activation} {
+		h.model.AddTokens(storage.Token{
+			Hash:   token.Hash,
+			UserID: token.UserID,
+			Expiry: pgtype.Timestamptz{Time: token.Expiry, Valid: true},
+			Scope:  token.Scope,
+		})
+	}
+
+	us := NewUserService(h.model, &mocks.Notifier[*User]{})
+
+	tcs := []struct {
+		name          string
+		token         string
+		injectDBError error
+		expectedError error
+	}{
+		{name: "valid token", token: valid.Plaintext},
+		{name: "malformed token", token: "abc", expectedError: ErrInvalidToken},
+		{name: "expired token", token: expired.Plaintext, expectedError: ErrInvalidToken},
+		{name: "activation token", token: activation.Plaintext, expectedError: ErrInvalidToken},
+		{name: "db error", token: valid.Plaintext, injectDBError: errInjectedDBError, expectedError: errInjectedDBError},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.injectDBError != nil {
+				h.model.FailOnNextCall(tc.injectDBError)
+			}
+
+			authenticated, err := us.AuthenticateUser(context.Background(), tc.token)
+			h.assertError(tc.expectedError, err)
+			if tc.expectedError == nil && authenticated.ID != user.ID {
+				t.Errorf("expected user %d; got %d", user.ID, authenticated.ID)
+			}
+		})
+	}
+}
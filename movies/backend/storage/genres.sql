@@ -0,0 +1,38 @@
+-- name: ListGenres :many
+SELECT sqlc.embed(genres),
+  coalesce(array_agg(genre_aliases.alias ORDER BY genre_aliases.alias) FILTER (WHERE genre_aliases.alias IS NOT NULL), '{}')::text[] AS aliases
+FROM genres
+LEFT JOIN genre_aliases ON genre_aliases.genre_id = genres.id
+GROUP BY genres.id
+ORDER BY genres.slug;
+
+-- name: GetGenre :one
+SELECT sqlc.embed(genres),
+  coalesce(array_agg(genre_aliases.alias ORDER BY genre_aliases.alias) FILTER (WHERE genre_aliases.alias IS NOT NULL), '{}')::text[] AS aliases
+FROM genres
+LEFT JOIN genre_aliases ON genre_aliases.genre_id = genres.id
+WHERE genres.slug = $1
+GROUP BY genres.id;
+
+-- name: CreateGenre :one
+INSERT INTO genres (slug, name)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpdateGenre :one
+UPDATE genres
+SET name = $2
+WHERE slug = $1
+RETURNING *;
+
+-- name: DeleteGenre :execrows
+DELETE FROM genres
+WHERE slug = $1;
+
+-- name: CreateGenreAliases :exec
+INSERT INTO genre_aliases (alias, genre_id)
+SELECT unnest(sqlc.arg(aliases)::text[]), sqlc.arg(genre_id)::bigint;
+
+-- name: DeleteGenreAliases :exec
+DELETE FROM genre_aliases
+WHERE genre_id = $1;
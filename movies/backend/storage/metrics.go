@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Statter is implemented by *pgxpool.Pool.
+type Statter interface {
+	Stat() *pgxpool.Stat
+}
+
+// PoolCollector exports the statistics of a pgx connection pool to
+// Prometheus. The statistics are read on every scrape.
+type PoolCollector struct {
+	pool Statter
+
+	acquireCount            *prometheus.Desc
+	acquireDuration         *prometheus.Desc
+	acquiredConns           *prometheus.Desc
+	canceledAcquireCount    *prometheus.Desc
+	constructingConns       *prometheus.Desc
+	emptyAcquireCount       *prometheus.Desc
+	idleConns               *prometheus.Desc
+	maxConns                *prometheus.Desc
+	totalConns              *prometheus.Desc
+	newConnsCount           *prometheus.Desc
+	maxLifetimeDestroyCount *prometheus.Desc
+	maxIdleDestroyCount     *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+func NewPoolCollector(pool Statter) *PoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("pgxpool_"+name, help, nil, nil)
+	}
+
+	return &PoolCollector{
+		pool:                    pool,
+		acquireCount:            desc("acquire_count_total", "Number of successful connection acquisitions from the pool."),
+		acquireDuration:         desc("acquire_duration_seconds_total", "Total time spent acquiring connections from the pool."),
+		acquiredConns:           desc("acquired_conns", "Number of connections currently acquired from the pool."),
+		canceledAcquireCount:    desc("canceled_acquire_count_total", "Number of acquisitions which were canceled by their context."),
+		constructingConns:       desc("constructing_conns", "Number of connections currently being established."),
+		emptyAcquireCount:       desc("empty_acquire_count_total", "Number of acquisitions which had to wait because the pool was empty."),
+		idleConns:               desc("idle_conns", "Number of idle connections in the pool."),
+		maxConns:                desc("max_conns", "Maximum size of the pool."),
+		totalConns:              desc("total_conns", "Number of connections in the pool."),
+		newConnsCount:           desc("new_conns_count_total", "Number of connections opened by the pool."),
+		maxLifetimeDestroyCount: desc("max_lifetime_destroy_count_total", "Number of connections closed because they exceeded their maximum lifetime."),
+		maxIdleDestroyCount:     desc("max_idle_destroy_count_total", "Number of connections closed because they were idle for too long."),
+	}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+
+	counter(c.acquireCount, float64(stat.AcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+	gauge(c.acquiredConns, float64(stat.AcquiredConns()))
+	counter(c.canceledAcquireCount, float64(stat.CanceledAcquireCount()))
+	gauge(c.constructingConns, float64(stat.ConstructingConns()))
+	counter(c.emptyAcquireCount, float64(stat.EmptyAcquireCount()))
+	gauge(c.idleConns, float64(stat.IdleConns()))
+	gauge(c.maxConns, float64(stat.MaxConns()))
+	gauge(c.totalConns, float64(stat.TotalConns()))
+	counter(c.newConnsCount, float64(stat.NewConnsCount()))
+	counter(c.maxLifetimeDestroyCount, float64(stat.MaxLifetimeDestroyCount()))
+	counter(c.maxIdleDestroyCount, float64(stat.MaxIdleDestroyCount()))
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS movies_title_trgm_idx;
+DROP INDEX IF EXISTS movies_search_vector_idx;
+ALTER TABLE movies DROP COLUMN IF EXISTS search_vector;
+DROP EXTENSION IF EXISTS pg_trgm;
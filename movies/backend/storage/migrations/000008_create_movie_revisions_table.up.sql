@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS movie_revisions (
+  id bigserial PRIMARY KEY,
+  movie_id bigint NOT NULL REFERENCES movies ON DELETE CASCADE,
+  version integer NOT NULL,
+  operation text NOT NULL,
+  title text NOT NULL,
+  year integer NOT NULL,
+  runtime_min integer NOT NULL,
+  genres text[] NOT NULL,
+  changed_fields text[] NOT NULL,
+  user_id bigint REFERENCES users ON DELETE SET NULL,
+  trace_id text NOT NULL DEFAULT '',
+  created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS movie_revisions_movie_id_idx ON movie_revisions (movie_id, version);
+
+-- History starts with the movies as they are now.
+INSERT INTO movie_revisions (movie_id, version, operation, title, year, runtime_min, genres, changed_fields)
+SELECT id, version, 'snapshot', title, year, runtime_min, genres, '{}'
+FROM movies;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS movies_sync_genres ON movies;
+DROP FUNCTION IF EXISTS sync_movie_genres();
+DROP TABLE IF EXISTS movie_genres;
+DROP TABLE IF EXISTS genre_aliases;
+DROP TABLE IF EXISTS genres;
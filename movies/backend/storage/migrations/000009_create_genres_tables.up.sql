@@ -0,0 +1,123 @@
+CREATE TABLE IF NOT EXISTS genres (
+  id bigserial PRIMARY KEY,
+  slug text UNIQUE NOT NULL CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+  name text NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
+
+-- Aliases are other spellings of a genre, stored as slugs. They are mapped to
+-- the genre when movies are written.
+CREATE TABLE IF NOT EXISTS genre_aliases (
+  alias text PRIMARY KEY,
+  genre_id bigint NOT NULL REFERENCES genres ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS genre_aliases_genre_id_idx ON genre_aliases (genre_id);
+
+INSERT INTO genres (slug, name)
+VALUES
+  ('action', 'Action'),
+  ('adventure', 'Adventure'),
+  ('animation', 'Animation'),
+  ('comedy', 'Comedy'),
+  ('crime', 'Crime'),
+  ('documentary', 'Documentary'),
+  ('drama', 'Drama'),
+  ('family', 'Family'),
+  ('fantasy', 'Fantasy'),
+  ('history', 'History'),
+  ('horror', 'Horror'),
+  ('music', 'Music'),
+  ('mystery', 'Mystery'),
+  ('romance', 'Romance'),
+  ('sci-fi', 'Science Fiction'),
+  ('thriller', 'Thriller'),
+  ('war', 'War'),
+  ('western', 'Western')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO genre_aliases (alias, genre_id)
+SELECT aliases.alias, genres.id
+FROM (VALUES
+  ('science-fiction', 'sci-fi'),
+  ('scifi', 'sci-fi'),
+  ('sf', 'sci-fi'),
+  ('animated', 'animation'),
+  ('historical', 'history'),
+  ('musical', 'music'),
+  ('romantic', 'romance')
+) AS aliases (alias, slug)
+JOIN genres ON genres.slug = aliases.slug
+ON CONFLICT DO NOTHING;
+
+-- genre_slug is how the application turns a spelling into a slug: lower case
+-- with runs of other characters replaced by a dash.
+CREATE FUNCTION pg_temp.genre_slug(spelling text) RETURNS text AS $$
+  SELECT coalesce(nullif(trim(both '-' FROM regexp_replace(lower(spelling), '[^a-z0-9]+', '-', 'g')), ''), 'other')
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Spellings in use which are neither a genre nor an alias become genres of
+-- their own, they can be merged into another genre later by deleting them
+-- and adding them as an alias.
+INSERT INTO genres (slug, name)
+SELECT pg_temp.genre_slug(spelling), min(initcap(trim(spelling)))
+FROM movies, unnest(movies.genres) AS spelling
+WHERE pg_temp.genre_slug(spelling) NOT IN (
+  SELECT slug FROM genres
+  UNION ALL
+  SELECT alias FROM genre_aliases
+)
+GROUP BY 1;
+
+-- The genres column keeps the slugs of the canonical genres, in their
+-- original order and without the duplicates left by merged spellings.
+UPDATE movies
+SET genres = ARRAY(
+  SELECT genres.slug
+  FROM unnest(movies.genres) WITH ORDINALITY AS spellings (spelling, n)
+  LEFT JOIN genre_aliases ON genre_aliases.alias = pg_temp.genre_slug(spellings.spelling)
+  JOIN genres ON genres.id = coalesce(
+    genre_aliases.genre_id,
+    (SELECT id FROM genres WHERE slug = pg_temp.genre_slug(spellings.spelling))
+  )
+  GROUP BY genres.slug
+  ORDER BY min(spellings.n)
+);
+
+-- movie_genres references the genres of every movie, so that genres in use
+-- can not be deleted. The listing filters and the export keep reading the
+-- genres column, the trigger below keeps the two in step.
+CREATE TABLE IF NOT EXISTS movie_genres (
+  movie_id bigint NOT NULL REFERENCES movies ON DELETE CASCADE,
+  genre_id bigint NOT NULL REFERENCES genres,
+  PRIMARY KEY (movie_id, genre_id)
+);
+
+CREATE INDEX IF NOT EXISTS movie_genres_genre_id_idx ON movie_genres (genre_id);
+
+INSERT INTO movie_genres (movie_id, genre_id)
+SELECT movies.id, genres.id
+FROM movies
+JOIN genres ON genres.slug = ANY (movies.genres);
+
+CREATE OR REPLACE FUNCTION sync_movie_genres() RETURNS trigger AS $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM unnest(NEW.genres) AS slugs (slug)
+    WHERE NOT EXISTS (SELECT 1 FROM genres WHERE genres.slug = slugs.slug)
+  ) THEN
+    RAISE EXCEPTION 'movie % has an unknown genre: %', NEW.id, NEW.genres
+      USING ERRCODE = 'foreign_key_violation', CONSTRAINT = 'movie_genres_genre_id_fkey';
+  END IF;
+
+  DELETE FROM movie_genres WHERE movie_id = NEW.id;
+  INSERT INTO movie_genres (movie_id, genre_id)
+  SELECT NEW.id, genres.id FROM genres WHERE genres.slug = ANY (NEW.genres);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER movies_sync_genres
+AFTER INSERT OR UPDATE OF genres ON movies
+FOR EACH ROW EXECUTE FUNCTION sync_movie_genres();
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS people (
+  id bigserial PRIMARY KEY,
+  name text NOT NULL CHECK (name <> ''),
+  birth_year integer CHECK (birth_year >= 1800),
+  created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
+
+-- Credits link people to the movies they worked on. The same person can be
+-- credited more than once on a movie, as long as the role or the character
+-- differs. People who are credited can not be deleted.
+CREATE TABLE IF NOT EXISTS movie_credits (
+  id bigserial PRIMARY KEY,
+  movie_id bigint NOT NULL REFERENCES movies ON DELETE CASCADE,
+  person_id bigint NOT NULL REFERENCES people,
+  role text NOT NULL CHECK (role IN ('director', 'writer', 'producer', 'actor', 'composer', 'cinematographer', 'editor')),
+  character_name text NOT NULL DEFAULT '',
+  billing_order integer NOT NULL CHECK (billing_order > 0),
+  created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+  UNIQUE (movie_id, person_id, role, character_name)
+);
+
+CREATE INDEX IF NOT EXISTS movie_credits_person_id_idx ON movie_credits (person_id);
@@ -1,23 +1,39 @@
 package migrations
 
 import (
+	"context"
 	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
 )
 
 //go:embed *.sql
 var fs embed.FS
 
-func Up(connectionString string) error {
+// ErrNoChange is returned by Up and Down when there was nothing to apply.
+var ErrNoChange = migrate.ErrNoChange
+
+// ErrNilVersion is returned by Version when no migration was applied yet.
+var ErrNilVersion = migrate.ErrNilVersion
+
+func newMigrate(connectionString string) (*migrate.Migrate, error) {
 	d, err := iofs.New(fs, ".")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	m, err := migrate.NewWithSourceInstance("iofs", d, connectionString)
+	return migrate.NewWithSourceInstance("iofs", d, connectionString)
+}
+
+func Up(connectionString string) error {
+	m, err := newMigrate(connectionString)
 	if err != nil {
 		return err
 	}
@@ -29,3 +45,100 @@ func Up(connectionString string) error {
 	}
 	return nil
 }
+
+// Down rolls back the given number of migrations, or all of them when steps
+// is not positive.
+func Down(connectionString string, steps int) error {
+	m, err := newMigrate(connectionString)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if steps <= 0 {
+		return m.Down()
+	}
+	return m.Steps(-steps)
+}
+
+// Version returns the version of the schema and whether the migration to it
+// failed half way.
+func Version(connectionString string) (version uint, dirty bool, err error) {
+	m, err := newMigrate(connectionString)
+	if err != nil {
+		return 0, false, err
+	}
+	defer m.Close()
+
+	return m.Version()
+}
+
+// Force sets the version of the schema without running any migration and
+// clears the dirty flag, after a failed migration was cleaned up by hand.
+// A version of -1 means that no migration is applied.
+func Force(connectionString string, version int) error {
+	m, err := newMigrate(connectionString)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	return m.Force(version)
+}
+
+// Latest returns the version of the newest embedded migration.
+var Latest = sync.OnceValues(func() (uint, error) {
+	d, err := iofs.New(fs, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer d.Close()
+
+	version, err := d.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := d.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+})
+
+// DB is satisfied by pgx connections and pools.
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Check verifies that the schema is at the latest embedded version and that
+// no migration was left half applied.
+func Check(ctx context.Context, db DB) error {
+	latest, err := Latest()
+	if err != nil {
+		return err
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err = db.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	switch {
+	case dirty:
+		return fmt.Errorf("migration %d failed and left the schema dirty", version)
+	case uint(version) != latest:
+		return fmt.Errorf("schema is at version %d, expected %d", version, latest)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type fakeRow struct {
+	version int64
+	dirty   bool
+	err     error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*int64) = r.version
+	*dest[1].(*bool) = r.dirty
+	return nil
+}
+
+type fakeDB struct {
+	row fakeRow
+}
+
+func (db fakeDB) QueryRow(context.Context, string, ...any) pgx.Row {
+	return db.row
+}
+
+func TestCheck(t *testing.T) {
+	latest, err := Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest == 0 {
+		t.Fatal("expected at least one migration")
+	}
+
+	tests := []struct {
+		name    string
+		row     fakeRow
+		wantErr bool
+	}{
+		{name: "up to date", row: fakeRow{version: int64(latest)}},
+		{name: "behind", row: fakeRow{version: int64(latest) - 1}, wantErr: true},
+		{name: "dirty", row: fakeRow{version: int64(latest), dirty: true}, wantErr: true},
+		{name: "not migrated", row: fakeRow{err: errors.New(`relation "schema_migrations" does not exist`)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(context.Background(), fakeDB{tt.row})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
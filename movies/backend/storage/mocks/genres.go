@@ -0,0 +1,209 @@
+package mocks
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+// foreignKeyViolation is the Postgres error code returned for references to
+// missing rows, and for deleting rows which are still referenced.
+const foreignKeyViolation = "23503"
+
+// TestGenres is the catalogue every Reset starts with, the genres added by
+// the migration which created the table.
+var TestGenres = map[string]string{
+	"action":      "Action",
+	"adventure":   "Adventure",
+	"animation":   "Animation",
+	"comedy":      "Comedy",
+	"crime":       "Crime",
+	"documentary": "Documentary",
+	"drama":       "Drama",
+	"family":      "Family",
+	"fantasy":     "Fantasy",
+	"history":     "History",
+	"horror":      "Horror",
+	"music":       "Music",
+	"mystery":     "Mystery",
+	"romance":     "Romance",
+	"sci-fi":      "Science Fiction",
+	"thriller":    "Thriller",
+	"war":         "War",
+	"western":     "Western",
+}
+
+// TestGenreAliases are the aliases of TestGenres.
+var TestGenreAliases = map[string]string{
+	"science-fiction": "sci-fi",
+	"scifi":           "sci-fi",
+	"sf":              "sci-fi",
+	"animated":        "animation",
+	"historical":      "history",
+	"musical":         "music",
+	"romantic":        "romance",
+}
+
+func (mq *MockQueries) resetGenres() {
+	mq.genres = map[int64]storage.Genre{}
+	mq.aliases = map[string]int64{}
+	mq.nextGenreID = 1
+
+	for _, slug := range slices.Sorted(maps.Keys(TestGenres)) {
+		_, _ = mq.createGenre(storage.CreateGenreParams{Slug: slug, Name: TestGenres[slug]})
+	}
+	for alias, slug := range TestGenreAliases {
+		genre, _ := mq.genreBySlug(slug)
+		mq.aliases[alias] = genre.ID
+	}
+}
+
+func (mq *MockQueries) genreBySlug(slug string) (storage.Genre, bool) {
+	for _, genre := range mq.genres {
+		if genre.Slug == slug {
+			return genre, true
+		}
+	}
+	return storage.Genre{}, false
+}
+
+func (mq *MockQueries) genreAliases(genreID int64) []string {
+	aliases := []string{}
+	for alias, id := range mq.aliases {
+		if id == genreID {
+			aliases = append(aliases, alias)
+		}
+	}
+	slices.Sort(aliases)
+	return aliases
+}
+
+func (mq *MockQueries) ListGenres(_ context.Context) ([]storage.ListGenresRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	var rows []storage.ListGenresRow
+	for _, genre := range mq.genres {
+		rows = append(rows, storage.ListGenresRow{Genre: genre, Aliases: mq.genreAliases(genre.ID)})
+	}
+	slices.SortFunc(rows, func(a, b storage.ListGenresRow) int {
+		return cmp.Compare(a.Genre.Slug, b.Genre.Slug)
+	})
+
+	return rows, nil
+}
+
+func (mq *MockQueries) GetGenre(_ context.Context, slug string) (storage.GetGenreRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.GetGenreRow{}, err
+	}
+
+	genre, ok := mq.genreBySlug(slug)
+	if !ok {
+		return storage.GetGenreRow{}, sql.ErrNoRows
+	}
+
+	return storage.GetGenreRow{Genre: genre, Aliases: mq.genreAliases(genre.ID)}, nil
+}
+
+func (mq *MockQueries) CreateGenre(_ context.Context, arg storage.CreateGenreParams) (storage.Genre, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Genre{}, err
+	}
+
+	return mq.createGenre(arg)
+}
+
+func (mq *MockQueries) createGenre(arg storage.CreateGenreParams) (storage.Genre, error) {
+	if _, exists := mq.genreBySlug(arg.Slug); exists {
+		return storage.Genre{}, &pgconn.PgError{Code: uniqueViolation, ConstraintName: "genres_slug_key"}
+	}
+
+	genre := storage.Genre{
+		ID:        mq.nextGenreID,
+		Slug:      arg.Slug,
+		Name:      arg.Name,
+		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	mq.genres[genre.ID] = genre
+	mq.nextGenreID++
+
+	return genre, nil
+}
+
+func (mq *MockQueries) UpdateGenre(_ context.Context, arg storage.UpdateGenreParams) (storage.Genre, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Genre{}, err
+	}
+
+	genre, ok := mq.genreBySlug(arg.Slug)
+	if !ok {
+		return storage.Genre{}, sql.ErrNoRows
+	}
+
+	genre.Name = arg.Name
+	mq.genres[genre.ID] = genre
+
+	return genre, nil
+}
+
+// DeleteGenre fails like the foreign key of movie_genres when a movie, even
+// a deleted one, has the genre.
+func (mq *MockQueries) DeleteGenre(_ context.Context, slug string) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	genre, ok := mq.genreBySlug(slug)
+	if !ok {
+		return 0, nil
+	}
+
+	for _, movie := range mq.movies {
+		if slices.Contains(movie.Genres, slug) {
+			return 0, &pgconn.PgError{Code: foreignKeyViolation, ConstraintName: "movie_genres_genre_id_fkey"}
+		}
+	}
+
+	delete(mq.genres, genre.ID)
+	maps.DeleteFunc(mq.aliases, func(_ string, id int64) bool {
+		return id == genre.ID
+	})
+
+	return 1, nil
+}
+
+func (mq *MockQueries) CreateGenreAliases(_ context.Context, arg storage.CreateGenreAliasesParams) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	for _, alias := range arg.Aliases {
+		if _, exists := mq.aliases[alias]; exists {
+			return &pgconn.PgError{Code: uniqueViolation, ConstraintName: "genre_aliases_pkey"}
+		}
+		mq.aliases[alias] = arg.GenreID
+	}
+
+	return nil
+}
+
+func (mq *MockQueries) DeleteGenreAliases(_ context.Context, genreID int64) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	maps.DeleteFunc(mq.aliases, func(_ string, id int64) bool {
+		return id == genreID
+	})
+
+	return nil
+}
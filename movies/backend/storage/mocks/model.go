@@ -1,8 +1,13 @@
 package mocks
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"iter"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -24,8 +29,22 @@ var TestMovie1 = storage.Movie{
 }
 
 type MockQueries struct {
-	movies     map[int64]storage.Movie
-	failOnNext error
+	movies       map[int64]storage.Movie
+	nextID       int64
+	users        map[int64]storage.User
+	tokens       map[string]storage.Token
+	grants       map[int64][]string
+	revisions    []storage.MovieRevision
+	genres       map[int64]storage.Genre
+	aliases      map[string]int64
+	nextGenreID  int64
+	people       map[int64]storage.Person
+	nextPersonID int64
+	credits      map[int64]storage.MovieCredit
+	nextCreditID int64
+	failOnNext   error
+	commits      int
+	rollbacks    int
 }
 
 var _ storage.Querier = &MockQueries{}
@@ -38,10 +57,32 @@ func NewMockQueries() *MockQueries {
 
 func (mq *MockQueries) Reset(existing ...storage.Movie) {
 	mq.failOnNext = nil
+	mq.commits = 0
+	mq.rollbacks = 0
 	mq.movies = map[int64]storage.Movie{}
+	mq.nextID = 1
+	mq.users = map[int64]storage.User{}
+	mq.tokens = map[string]storage.Token{}
+	mq.grants = map[int64][]string{}
+	mq.revisions = nil
+	mq.resetGenres()
+	mq.resetPeople()
 
+	// Like the migration which added the history, existing movies start
+	// with a snapshot of their current state.
 	for _, movie := range existing {
 		mq.movies[movie.ID] = movie
+		mq.nextID = max(mq.nextID, movie.ID+1)
+		mq.addRevision(storage.CreateMovieRevisionParams{
+			MovieID:       movie.ID,
+			Version:       movie.Version,
+			Operation:     "snapshot",
+			Title:         movie.Title,
+			Year:          movie.Year,
+			RuntimeMin:    movie.RuntimeMin,
+			Genres:        movie.Genres,
+			ChangedFields: []string{},
+		})
 	}
 }
 
@@ -65,7 +106,7 @@ func (mq *MockQueries) CreateMovie(_ context.Context, arg storage.CreateMoviePar
 	}
 
 	movie := storage.Movie{
-		ID:      int64(len(mq.movies)) + 1,
+		ID:      mq.nextID,
 		Version: 1,
 		CreatedAt: pgtype.Timestamptz{
 			Time: time.Now(),
@@ -77,10 +118,34 @@ func (mq *MockQueries) CreateMovie(_ context.Context, arg storage.CreateMoviePar
 	}
 
 	mq.movies[movie.ID] = movie
+	mq.nextID++
 
 	return movie, nil
 }
 
+func (mq *MockQueries) CreateMovies(_ context.Context, arg []storage.CreateMoviesParams) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	for _, m := range arg {
+		mq.movies[m.ID] = storage.Movie{
+			ID:      m.ID,
+			Version: 1,
+			CreatedAt: pgtype.Timestamptz{
+				Time: time.Now(),
+			},
+			Title:      m.Title,
+			Year:       m.Year,
+			RuntimeMin: m.RuntimeMin,
+			Genres:     m.Genres,
+		}
+		mq.nextID = max(mq.nextID, m.ID+1)
+	}
+
+	return int64(len(arg)), nil
+}
+
 func (mq *MockQueries) GetMovie(_ context.Context, id int64) (storage.Movie, error) {
 	if err := mq.checkForFailure(); err != nil {
 		return storage.Movie{}, err
@@ -88,28 +153,239 @@ func (mq *MockQueries) GetMovie(_ context.Context, id int64) (storage.Movie, err
 
 	movie, ok := mq.movies[id]
 
-	if !ok {
+	if !ok || movie.DeletedAt.Valid {
 		return storage.Movie{}, sql.ErrNoRows
 	}
 
 	return movie, nil
 }
 
-func (mq *MockQueries) ListMovies(_ context.Context) ([]storage.Movie, error) {
+func (mq *MockQueries) ListMovies(_ context.Context, arg storage.ListMoviesParams) ([]storage.ListMoviesRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	movies := make([]storage.Movie, 0, len(mq.movies))
+	for _, movie := range mq.movies {
+		if !movie.DeletedAt.Valid && mq.matchesFilters(&movie, &arg) {
+			movies = append(movies, movie)
+		}
+	}
+
+	slices.SortFunc(movies, func(a, b storage.Movie) int {
+		return compareMovies(&a, &b, arg.Sort)
+	})
+
+	total := int64(len(movies))
+	start := min(int(arg.PageOffset), len(movies))
+	end := min(start+int(arg.PageSize), len(movies))
+
+	rows := make([]storage.ListMoviesRow, 0, end-start)
+	for _, movie := range movies[start:end] {
+		rows = append(rows, storage.ListMoviesRow{TotalRecords: total, Movie: movie})
+	}
+
+	return rows, nil
+}
+
+func (mq *MockQueries) ListMoviesKeyset(_ context.Context, arg storage.ListMoviesKeysetParams) ([]storage.Movie, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	filters := storage.ListMoviesParams{
+		Title:      arg.Title,
+		Genres:     arg.Genres,
+		YearMin:    arg.YearMin,
+		YearMax:    arg.YearMax,
+		RuntimeMin: arg.RuntimeMin,
+		RuntimeMax: arg.RuntimeMax,
+		PersonID:   arg.PersonID,
+	}
+	after := storage.Movie{
+		ID:         arg.AfterID,
+		Title:      arg.AfterTitle,
+		Year:       arg.AfterYear,
+		RuntimeMin: arg.AfterRuntime,
+	}
+
+	movies := make([]storage.Movie, 0, len(mq.movies))
+	for _, movie := range mq.movies {
+		if movie.DeletedAt.Valid || !mq.matchesFilters(&movie, &filters) {
+			continue
+		}
+		if arg.AfterID != 0 && compareMoviesKeyset(&movie, &after, arg.Sort) <= 0 {
+			continue
+		}
+		movies = append(movies, movie)
+	}
+
+	slices.SortFunc(movies, func(a, b storage.Movie) int {
+		return compareMoviesKeyset(&a, &b, arg.Sort)
+	})
+
+	return movies[:min(int(arg.PageSize), len(movies))], nil
+}
+
+func (mq *MockQueries) ExportMovies(_ context.Context, arg storage.ExportMoviesParams) ([]storage.Movie, error) {
 	if err := mq.checkForFailure(); err != nil {
 		return nil, err
 	}
 
-	movies := make([]storage.Movie, len(mq.movies))
-	i := 0
+	filters := storage.ListMoviesParams{
+		Title:      arg.Title,
+		Genres:     arg.Genres,
+		YearMin:    arg.YearMin,
+		YearMax:    arg.YearMax,
+		RuntimeMin: arg.RuntimeMin,
+		RuntimeMax: arg.RuntimeMax,
+		PersonID:   arg.PersonID,
+	}
+
+	movies := make([]storage.Movie, 0, len(mq.movies))
 	for _, movie := range mq.movies {
-		movies[i] = movie
-		i++
+		if !movie.DeletedAt.Valid && mq.matchesFilters(&movie, &filters) {
+			movies = append(movies, movie)
+		}
 	}
 
+	slices.SortFunc(movies, func(a, b storage.Movie) int {
+		return compareMovies(&a, &b, arg.Sort)
+	})
+
 	return movies, nil
 }
 
+// StreamMovies yields the movies returned by ExportMovies.
+func (mq *MockQueries) StreamMovies(ctx context.Context, arg storage.ExportMoviesParams) iter.Seq2[storage.Movie, error] {
+	return func(yield func(storage.Movie, error) bool) {
+		movies, err := mq.ExportMovies(ctx, arg)
+		if err != nil {
+			yield(storage.Movie{}, err)
+			return
+		}
+
+		for _, movie := range movies {
+			if !yield(movie, nil) {
+				return
+			}
+		}
+	}
+}
+
+// escapeHTML escapes the title the same way SearchMovies does before
+// highlighting it.
+var escapeHTML = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+// SearchMovies approximates the full-text search with a case-insensitive
+// substring match, ranking shorter titles higher.
+func (mq *MockQueries) SearchMovies(_ context.Context, arg storage.SearchMoviesParams) ([]storage.SearchMoviesRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	query := strings.ToLower(arg.Query)
+
+	rows := make([]storage.SearchMoviesRow, 0, len(mq.movies))
+	for _, movie := range mq.movies {
+		start := strings.Index(strings.ToLower(movie.Title), query)
+		if movie.DeletedAt.Valid || start < 0 {
+			continue
+		}
+
+		end := start + len(query)
+		rows = append(rows, storage.SearchMoviesRow{
+			Movie:   movie,
+			Rank:    float32(len(query)) / float32(len(movie.Title)),
+			Snippet: escapeHTML(movie.Title[:start]) + "<mark>" + escapeHTML(movie.Title[start:end]) + "</mark>" + escapeHTML(movie.Title[end:]),
+		})
+	}
+
+	slices.SortFunc(rows, func(a, b storage.SearchMoviesRow) int {
+		if c := cmp.Compare(b.Rank, a.Rank); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Movie.ID, b.Movie.ID)
+	})
+
+	return rows[:min(int(arg.PageSize), len(rows))], nil
+}
+
+// matchesFilters mirrors the WHERE clause of the ListMovies query.
+func (mq *MockQueries) matchesFilters(movie *storage.Movie, arg *storage.ListMoviesParams) bool {
+	if arg.Title != "" && !likeRegexp(arg.Title).MatchString(movie.Title) {
+		return false
+	}
+
+	for _, genre := range arg.Genres {
+		if !slices.Contains(movie.Genres, genre) {
+			return false
+		}
+	}
+
+	switch {
+	case arg.YearMin != 0 && movie.Year < arg.YearMin,
+		arg.YearMax != 0 && movie.Year > arg.YearMax,
+		arg.RuntimeMin != 0 && movie.RuntimeMin < arg.RuntimeMin,
+		arg.RuntimeMax != 0 && movie.RuntimeMin > arg.RuntimeMax,
+		arg.PersonID != 0 && !mq.hasCredit(movie.ID, arg.PersonID):
+		return false
+	}
+
+	return true
+}
+
+// likeRegexp translates the title filter, which the queries match with
+// ILIKE '%' || title || '%', into a regular expression. Like in Postgres, %
+// and _ are wildcards unless they are escaped with a backslash.
+func likeRegexp(title string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)")
+
+	escaped := false
+	for _, r := range title {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			b.WriteString(".*")
+		case r == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return regexp.MustCompile(b.String())
+}
+
+// compareMovies mirrors the ORDER BY clause of the ListMovies query.
+func compareMovies(a, b *storage.Movie, sort string) int {
+	var c int
+	switch strings.TrimPrefix(sort, "-") {
+	case "title":
+		c = strings.Compare(a.Title, b.Title)
+	case "year":
+		c = cmp.Compare(a.Year, b.Year)
+	case "runtime":
+		c = cmp.Compare(a.RuntimeMin, b.RuntimeMin)
+	case "id":
+		c = cmp.Compare(a.ID, b.ID)
+	}
+
+	if strings.HasPrefix(sort, "-") {
+		c = -c
+	}
+
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+	return c
+}
+
 func (mq *MockQueries) UpdateMovie(_ context.Context, arg storage.UpdateMovieParams) (storage.Movie, error) {
 	if err := mq.checkForFailure(); err != nil {
 		return storage.Movie{}, err
@@ -117,7 +393,7 @@ func (mq *MockQueries) UpdateMovie(_ context.Context, arg storage.UpdateMoviePar
 
 	oldMovie, ok := mq.movies[arg.ID]
 
-	if !ok {
+	if !ok || oldMovie.DeletedAt.Valid || oldMovie.Version != arg.Version {
 		return storage.Movie{}, sql.ErrNoRows
 	}
 
@@ -134,3 +410,82 @@ func (mq *MockQueries) UpdateMovie(_ context.Context, arg storage.UpdateMoviePar
 	mq.movies[newMovie.ID] = newMovie
 	return newMovie, nil
 }
+
+func (mq *MockQueries) DeleteMovie(_ context.Context, id int64) (storage.Movie, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Movie{}, err
+	}
+
+	movie, ok := mq.movies[id]
+
+	if !ok || movie.DeletedAt.Valid {
+		return storage.Movie{}, sql.ErrNoRows
+	}
+
+	movie.DeletedAt = pgtype.Timestamptz{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	mq.movies[movie.ID] = movie
+	return movie, nil
+}
+
+func (mq *MockQueries) ListDeletedMovies(_ context.Context) ([]storage.Movie, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	movies := make([]storage.Movie, 0, len(mq.movies))
+	for _, movie := range mq.movies {
+		if movie.DeletedAt.Valid {
+			movies = append(movies, movie)
+		}
+	}
+
+	return movies, nil
+}
+
+func (mq *MockQueries) RestoreMovie(_ context.Context, id int64) (storage.Movie, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Movie{}, err
+	}
+
+	movie, ok := mq.movies[id]
+
+	if !ok || !movie.DeletedAt.Valid {
+		return storage.Movie{}, sql.ErrNoRows
+	}
+
+	movie.DeletedAt = pgtype.Timestamptz{}
+
+	mq.movies[movie.ID] = movie
+	return movie, nil
+}
+
+func (mq *MockQueries) PurgeDeletedMovies(_ context.Context, deletedBefore pgtype.Timestamptz) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for id, movie := range mq.movies {
+		if movie.DeletedAt.Valid && movie.DeletedAt.Time.Before(deletedBefore.Time) {
+			delete(mq.movies, id)
+			mq.deleteCredits(id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// compareMoviesKeyset mirrors the ORDER BY clause of the ListMoviesKeyset
+// query, where the id tie-breaker follows the direction of the sort.
+func compareMoviesKeyset(a, b *storage.Movie, sort string) int {
+	c := compareMovies(a, b, strings.TrimPrefix(sort, "-"))
+	if strings.HasPrefix(sort, "-") {
+		c = -c
+	}
+	return c
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import "context"
+
+// Notifier records the last activation token the user service sent, use it
+// as Notifier[*service.User]. It is generic over the user so that the mocks
+// don't import the service, whose tests use them.
+type Notifier[U any] struct {
+	User  U
+	Token string
+	// Err is returned instead of sending the token when it is set.
+	Err error
+}
+
+func (n *Notifier[U]) SendActivationToken(_ context.Context, user U, token string) error {
+	if n.Err != nil {
+		return n.Err
+	}
+	n.User = user
+	n.Token = token
+	return nil
+}
@@ -0,0 +1,214 @@
+package mocks
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+func (mq *MockQueries) resetPeople() {
+	mq.people = map[int64]storage.Person{}
+	mq.nextPersonID = 1
+	mq.credits = map[int64]storage.MovieCredit{}
+	mq.nextCreditID = 1
+}
+
+// hasCredit reports whether the person is credited on the movie, like the
+// person_id filter of the movie listings.
+func (mq *MockQueries) hasCredit(movieID, personID int64) bool {
+	for _, credit := range mq.credits {
+		if credit.MovieID == movieID && credit.PersonID == personID {
+			return true
+		}
+	}
+	return false
+}
+
+func (mq *MockQueries) ListPeople(_ context.Context, arg storage.ListPeopleParams) ([]storage.ListPeopleRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	people := make([]storage.Person, 0, len(mq.people))
+	for _, person := range mq.people {
+		if arg.Name == "" || strings.Contains(strings.ToLower(person.Name), strings.ToLower(arg.Name)) {
+			people = append(people, person)
+		}
+	}
+	slices.SortFunc(people, func(a, b storage.Person) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	total := int64(len(people))
+	start := min(int(arg.PageOffset), len(people))
+	end := min(start+int(arg.PageSize), len(people))
+
+	rows := make([]storage.ListPeopleRow, 0, end-start)
+	for _, person := range people[start:end] {
+		rows = append(rows, storage.ListPeopleRow{TotalRecords: total, Person: person})
+	}
+
+	return rows, nil
+}
+
+func (mq *MockQueries) GetPerson(_ context.Context, id int64) (storage.Person, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Person{}, err
+	}
+
+	person, ok := mq.people[id]
+	if !ok {
+		return storage.Person{}, sql.ErrNoRows
+	}
+
+	return person, nil
+}
+
+func (mq *MockQueries) CreatePerson(_ context.Context, arg storage.CreatePersonParams) (storage.Person, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Person{}, err
+	}
+
+	person := storage.Person{
+		ID:        mq.nextPersonID,
+		Name:      arg.Name,
+		BirthYear: arg.BirthYear,
+		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	mq.people[person.ID] = person
+	mq.nextPersonID++
+
+	return person, nil
+}
+
+func (mq *MockQueries) UpdatePerson(_ context.Context, arg storage.UpdatePersonParams) (storage.Person, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.Person{}, err
+	}
+
+	person, ok := mq.people[arg.ID]
+	if !ok {
+		return storage.Person{}, sql.ErrNoRows
+	}
+
+	person.Name = arg.Name
+	person.BirthYear = arg.BirthYear
+	mq.people[person.ID] = person
+
+	return person, nil
+}
+
+// DeletePerson fails like the foreign key of movie_credits when the person
+// is credited on a movie, even a deleted one.
+func (mq *MockQueries) DeletePerson(_ context.Context, id int64) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	if _, ok := mq.people[id]; !ok {
+		return 0, nil
+	}
+
+	for _, credit := range mq.credits {
+		if credit.PersonID == id {
+			return 0, &pgconn.PgError{Code: foreignKeyViolation, ConstraintName: "movie_credits_person_id_fkey"}
+		}
+	}
+
+	delete(mq.people, id)
+
+	return 1, nil
+}
+
+func (mq *MockQueries) ListMovieCredits(_ context.Context, movieID int64) ([]storage.ListMovieCreditsRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	var rows []storage.ListMovieCreditsRow
+	for _, credit := range mq.credits {
+		if credit.MovieID == movieID {
+			rows = append(rows, storage.ListMovieCreditsRow{
+				MovieCredit: credit,
+				PersonName:  mq.people[credit.PersonID].Name,
+			})
+		}
+	}
+	slices.SortFunc(rows, func(a, b storage.ListMovieCreditsRow) int {
+		return cmp.Or(
+			cmp.Compare(a.MovieCredit.BillingOrder, b.MovieCredit.BillingOrder),
+			cmp.Compare(a.MovieCredit.ID, b.MovieCredit.ID),
+		)
+	})
+
+	return rows, nil
+}
+
+// CreateMovieCredit fails like the foreign keys and the unique constraint of
+// movie_credits.
+func (mq *MockQueries) CreateMovieCredit(_ context.Context, arg storage.CreateMovieCreditParams) (storage.MovieCredit, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.MovieCredit{}, err
+	}
+
+	if _, ok := mq.movies[arg.MovieID]; !ok {
+		return storage.MovieCredit{}, &pgconn.PgError{Code: foreignKeyViolation, ConstraintName: "movie_credits_movie_id_fkey"}
+	}
+	if _, ok := mq.people[arg.PersonID]; !ok {
+		return storage.MovieCredit{}, &pgconn.PgError{Code: foreignKeyViolation, ConstraintName: "movie_credits_person_id_fkey"}
+	}
+	for _, credit := range mq.credits {
+		if credit.MovieID == arg.MovieID && credit.PersonID == arg.PersonID &&
+			credit.Role == arg.Role && credit.CharacterName == arg.CharacterName {
+			return storage.MovieCredit{}, &pgconn.PgError{
+				Code:           uniqueViolation,
+				ConstraintName: "movie_credits_movie_id_person_id_role_character_name_key",
+			}
+		}
+	}
+
+	credit := storage.MovieCredit{
+		ID:            mq.nextCreditID,
+		MovieID:       arg.MovieID,
+		PersonID:      arg.PersonID,
+		Role:          arg.Role,
+		CharacterName: arg.CharacterName,
+		BillingOrder:  arg.BillingOrder,
+		CreatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	mq.credits[credit.ID] = credit
+	mq.nextCreditID++
+
+	return credit, nil
+}
+
+func (mq *MockQueries) DeleteMovieCredit(_ context.Context, arg storage.DeleteMovieCreditParams) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	credit, ok := mq.credits[arg.ID]
+	if !ok || credit.MovieID != arg.MovieID {
+		return 0, nil
+	}
+
+	delete(mq.credits, arg.ID)
+
+	return 1, nil
+}
+
+// deleteCredits removes the credits of a purged movie, like the cascade of
+// movie_credits.
+func (mq *MockQueries) deleteCredits(movieID int64) {
+	maps.DeleteFunc(mq.credits, func(_ int64, credit storage.MovieCredit) bool {
+		return credit.MovieID == movieID
+	})
+}
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+// permissionCodes mirrors the permissions seeded by the migrations.
+var permissionCodes = []string{"movies:write", "permissions:admin"}
+
+// AddPermissions grants the permissions to the user without going through
+// AddPermissionForUser.
+func (mq *MockQueries) AddPermissions(userID int64, codes ...string) {
+	for _, code := range codes {
+		if !slices.Contains(mq.grants[userID], code) {
+			mq.grants[userID] = append(mq.grants[userID], code)
+		}
+	}
+}
+
+func (mq *MockQueries) GetPermissionsForUser(_ context.Context, userID int64) ([]string, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	codes := slices.Clone(mq.grants[userID])
+	slices.Sort(codes)
+	return codes, nil
+}
+
+func (mq *MockQueries) AddPermissionForUser(_ context.Context, arg storage.AddPermissionForUserParams) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	if !slices.Contains(permissionCodes, arg.Code) || slices.Contains(mq.grants[arg.UserID], arg.Code) {
+		return 0, nil
+	}
+
+	mq.grants[arg.UserID] = append(mq.grants[arg.UserID], arg.Code)
+	return 1, nil
+}
+
+func (mq *MockQueries) RemovePermissionForUser(_ context.Context, arg storage.RemovePermissionForUserParams) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	i := slices.Index(mq.grants[arg.UserID], arg.Code)
+	if i < 0 {
+		return 0, nil
+	}
+
+	mq.grants[arg.UserID] = slices.Delete(mq.grants[arg.UserID], i, i+1)
+	return 1, nil
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+func (mq *MockQueries) CreateMovieRevision(_ context.Context, arg storage.CreateMovieRevisionParams) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	mq.addRevision(storage.CreateMovieRevisionParams(arg))
+	return nil
+}
+
+func (mq *MockQueries) CreateMovieRevisions(_ context.Context, arg []storage.CreateMovieRevisionsParams) (int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return 0, err
+	}
+
+	for _, r := range arg {
+		mq.addRevision(storage.CreateMovieRevisionParams(r))
+	}
+
+	return int64(len(arg)), nil
+}
+
+func (mq *MockQueries) addRevision(arg storage.CreateMovieRevisionParams) {
+	mq.revisions = append(mq.revisions, storage.MovieRevision{
+		ID:            int64(len(mq.revisions) + 1),
+		MovieID:       arg.MovieID,
+		Version:       arg.Version,
+		Operation:     arg.Operation,
+		Title:         arg.Title,
+		Year:          arg.Year,
+		RuntimeMin:    arg.RuntimeMin,
+		Genres:        arg.Genres,
+		ChangedFields: arg.ChangedFields,
+		UserID:        arg.UserID,
+		TraceID:       arg.TraceID,
+		CreatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+}
+
+func (mq *MockQueries) ListMovieRevisions(_ context.Context, movieID int64) ([]storage.MovieRevision, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	var revisions []storage.MovieRevision
+	for _, r := range mq.revisions {
+		if r.MovieID == movieID {
+			revisions = append(revisions, r)
+		}
+	}
+
+	slices.SortFunc(revisions, func(a, b storage.MovieRevision) int {
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	return revisions, nil
+}
+
+func (mq *MockQueries) GetMovieRevision(_ context.Context, arg storage.GetMovieRevisionParams) (storage.MovieRevision, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.MovieRevision{}, err
+	}
+
+	for _, r := range mq.revisions {
+		if r.MovieID == arg.MovieID && r.Version == arg.Version {
+			return r, nil
+		}
+	}
+
+	return storage.MovieRevision{}, sql.ErrNoRows
+}
+
+func (mq *MockQueries) ReserveMovieIDs(_ context.Context, count int32) ([]int64, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, count)
+	for i := range ids {
+		ids[i] = mq.nextID
+		mq.nextID++
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,100 @@
+package mocks
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+var _ storage.Store = &MockQueries{}
+
+// snapshot is a copy of the mock state, restored when a transaction is
+// rolled back.
+type snapshot struct {
+	movies map[int64]storage.Movie
+	nextID int64
+	users  map[int64]storage.User
+	tokens map[string]storage.Token
+	grants map[int64][]string
+
+	revisions []storage.MovieRevision
+
+	genres      map[int64]storage.Genre
+	aliases     map[string]int64
+	nextGenreID int64
+
+	people       map[int64]storage.Person
+	nextPersonID int64
+	credits      map[int64]storage.MovieCredit
+	nextCreditID int64
+}
+
+// InTx runs fn against the mock itself and restores the state from before
+// the call when fn fails. The options are ignored and fn is never retried.
+func (mq *MockQueries) InTx(_ context.Context, fn func(q storage.Querier) error, _ ...storage.TxOption) error {
+	before := mq.snapshot()
+
+	if err := fn(mq); err != nil {
+		mq.restore(before)
+		mq.rollbacks++
+		return err
+	}
+
+	mq.commits++
+	return nil
+}
+
+// Commits returns the number of transactions committed since the last Reset.
+func (mq *MockQueries) Commits() int {
+	return mq.commits
+}
+
+// Rollbacks returns the number of transactions rolled back since the last
+// Reset.
+func (mq *MockQueries) Rollbacks() int {
+	return mq.rollbacks
+}
+
+func (mq *MockQueries) snapshot() snapshot {
+	grants := make(map[int64][]string, len(mq.grants))
+	for userID, codes := range mq.grants {
+		grants[userID] = slices.Clone(codes)
+	}
+
+	return snapshot{
+		movies: maps.Clone(mq.movies),
+		nextID: mq.nextID,
+		users:  maps.Clone(mq.users),
+		tokens: maps.Clone(mq.tokens),
+		grants: grants,
+
+		revisions: slices.Clone(mq.revisions),
+
+		genres:      maps.Clone(mq.genres),
+		aliases:     maps.Clone(mq.aliases),
+		nextGenreID: mq.nextGenreID,
+
+		people:       maps.Clone(mq.people),
+		nextPersonID: mq.nextPersonID,
+		credits:      maps.Clone(mq.credits),
+		nextCreditID: mq.nextCreditID,
+	}
+}
+
+func (mq *MockQueries) restore(s snapshot) {
+	mq.movies = s.movies
+	mq.nextID = s.nextID
+	mq.users = s.users
+	mq.tokens = s.tokens
+	mq.grants = s.grants
+	mq.revisions = s.revisions
+	mq.genres = s.genres
+	mq.aliases = s.aliases
+	mq.nextGenreID = s.nextGenreID
+	mq.people = s.people
+	mq.nextPersonID = s.nextPersonID
+	mq.credits = s.credits
+	mq.nextCreditID = s.nextCreditID
+}
@@ -0,0 +1,165 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+// uniqueViolation is the Postgres error code returned for duplicate keys.
+const uniqueViolation = "23505"
+
+// AddUsers stores the users without going through CreateUser.
+func (mq *MockQueries) AddUsers(users ...storage.User) {
+	for _, user := range users {
+		mq.users[user.ID] = user
+	}
+}
+
+// AddTokens stores the tokens without going through CreateToken.
+func (mq *MockQueries) AddTokens(tokens ...storage.Token) {
+	for _, token := range tokens {
+		mq.tokens[string(token.Hash)] = token
+	}
+}
+
+func (mq *MockQueries) CreateUser(_ context.Context, arg storage.CreateUserParams) (storage.User, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.User{}, err
+	}
+
+	for _, user := range mq.users {
+		// The email column is citext, so the uniqueness check ignores case.
+		if strings.EqualFold(user.Email, arg.Email) {
+			return storage.User{}, &pgconn.PgError{Code: uniqueViolation, ConstraintName: "users_email_key"}
+		}
+	}
+
+	var lastID int64
+	for id := range mq.users {
+		lastID = max(lastID, id)
+	}
+
+	user := storage.User{
+		ID: lastID + 1,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		Name:         arg.Name,
+		Email:        arg.Email,
+		PasswordHash: arg.PasswordHash,
+		Version:      1,
+	}
+
+	mq.users[user.ID] = user
+	return user, nil
+}
+
+func (mq *MockQueries) GetUser(_ context.Context, id int64) (storage.User, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.User{}, err
+	}
+
+	user, ok := mq.users[id]
+	if !ok {
+		return storage.User{}, sql.ErrNoRows
+	}
+
+	return user, nil
+}
+
+func (mq *MockQueries) GetUserByEmail(_ context.Context, email string) (storage.User, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.User{}, err
+	}
+
+	for _, user := range mq.users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+
+	return storage.User{}, sql.ErrNoRows
+}
+
+func (mq *MockQueries) ActivateUser(_ context.Context, arg storage.ActivateUserParams) (storage.User, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.User{}, err
+	}
+
+	user, ok := mq.users[arg.ID]
+	if !ok || user.Version != arg.Version {
+		return storage.User{}, sql.ErrNoRows
+	}
+
+	user.Activated = true
+	user.Version++
+
+	mq.users[user.ID] = user
+	return user, nil
+}
+
+func (mq *MockQueries) CreateToken(_ context.Context, arg storage.CreateTokenParams) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	mq.tokens[string(arg.Hash)] = storage.Token(arg)
+	return nil
+}
+
+func (mq *MockQueries) GetUserForToken(_ context.Context, arg storage.GetUserForTokenParams) (storage.GetUserForTokenRow, error) {
+	if err := mq.checkForFailure(); err != nil {
+		return storage.GetUserForTokenRow{}, err
+	}
+
+	token, ok := mq.tokens[string(arg.Hash)]
+	if !ok || token.Scope != arg.Scope || !token.Expiry.Time.After(time.Now()) {
+		return storage.GetUserForTokenRow{}, sql.ErrNoRows
+	}
+
+	user, ok := mq.users[token.UserID]
+	if !ok {
+		return storage.GetUserForTokenRow{}, sql.ErrNoRows
+	}
+
+	return storage.GetUserForTokenRow{User: user}, nil
+}
+
+func (mq *MockQueries) DeleteTokensForUser(_ context.Context, arg storage.DeleteTokensForUserParams) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	for hash, token := range mq.tokens {
+		if token.Scope == arg.Scope && token.UserID == arg.UserID {
+			delete(mq.tokens, hash)
+		}
+	}
+
+	return nil
+}
+
+// DeleteUser also deletes the tokens and the permissions of the user, like
+// the cascading foreign keys.
+func (mq *MockQueries) DeleteUser(_ context.Context, id int64) error {
+	if err := mq.checkForFailure(); err != nil {
+		return err
+	}
+
+	delete(mq.users, id)
+	delete(mq.grants, id)
+	for hash, token := range mq.tokens {
+		if token.UserID == id {
+			delete(mq.tokens, hash)
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,18 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Genre struct {
+	ID        int64              `json:"id"`
+	Slug      string             `json:"slug"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type GenreAlias struct {
+	Alias   string `json:"alias"`
+	GenreID int64  `json:"genreId"`
+}
+
 type Movie struct {
 	ID         int64              `json:"id"`
 	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
@@ -16,4 +28,69 @@ type Movie struct {
 	RuntimeMin int32              `json:"runtimeMin"`
 	Genres     []string           `json:"genres"`
 	Version    int32              `json:"version"`
+	DeletedAt  pgtype.Timestamptz `json:"deletedAt"`
+}
+
+type MovieCredit struct {
+	ID            int64              `json:"id"`
+	MovieID       int64              `json:"movieId"`
+	PersonID      int64              `json:"personId"`
+	Role          string             `json:"role"`
+	CharacterName string             `json:"characterName"`
+	BillingOrder  int32              `json:"billingOrder"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+}
+
+type MovieGenre struct {
+	MovieID int64 `json:"movieId"`
+	GenreID int64 `json:"genreId"`
+}
+
+type MovieRevision struct {
+	ID            int64              `json:"id"`
+	MovieID       int64              `json:"movieId"`
+	Version       int32              `json:"version"`
+	Operation     string             `json:"operation"`
+	Title         string             `json:"title"`
+	Year          int32              `json:"year"`
+	RuntimeMin    int32              `json:"runtimeMin"`
+	Genres        []string           `json:"genres"`
+	ChangedFields []string           `json:"changedFields"`
+	UserID        pgtype.Int8        `json:"userId"`
+	TraceID       string             `json:"traceId"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+}
+
+type Person struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	BirthYear pgtype.Int4        `json:"birthYear"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type Permission struct {
+	ID   int64  `json:"id"`
+	Code string `json:"code"`
+}
+
+type Token struct {
+	Hash   []byte             `json:"hash"`
+	UserID int64              `json:"userId"`
+	Expiry pgtype.Timestamptz `json:"expiry"`
+	Scope  string             `json:"scope"`
+}
+
+type User struct {
+	ID           int64              `json:"id"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	Name         string             `json:"name"`
+	Email        string             `json:"email"`
+	PasswordHash []byte             `json:"passwordHash"`
+	Activated    bool               `json:"activated"`
+	Version      int32              `json:"version"`
+}
+
+type UserPermission struct {
+	UserID       int64 `json:"userId"`
+	PermissionID int64 `json:"permissionId"`
 }
@@ -0,0 +1,41 @@
+-- name: ListPeople :many
+SELECT count(*) OVER() AS total_records, sqlc.embed(people)
+FROM people
+WHERE (sqlc.arg(name)::text = '' OR name ILIKE '%' || sqlc.arg(name)::text || '%')
+ORDER BY name, id
+LIMIT sqlc.arg(page_size)::int OFFSET sqlc.arg(page_offset)::int;
+
+-- name: GetPerson :one
+SELECT * FROM people
+WHERE id = $1;
+
+-- name: CreatePerson :one
+INSERT INTO people (name, birth_year)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpdatePerson :one
+UPDATE people
+SET name = $2, birth_year = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePerson :execrows
+DELETE FROM people
+WHERE id = $1;
+
+-- name: ListMovieCredits :many
+SELECT sqlc.embed(movie_credits), people.name AS person_name
+FROM movie_credits
+JOIN people ON people.id = movie_credits.person_id
+WHERE movie_credits.movie_id = $1
+ORDER BY movie_credits.billing_order, movie_credits.id;
+
+-- name: CreateMovieCredit :one
+INSERT INTO movie_credits (movie_id, person_id, role, character_name, billing_order)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteMovieCredit :execrows
+DELETE FROM movie_credits
+WHERE id = $1 AND movie_id = $2;
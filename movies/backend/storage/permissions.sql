@@ -0,0 +1,19 @@
+-- name: GetPermissionsForUser :many
+SELECT permissions.code
+FROM permissions
+INNER JOIN user_permissions ON user_permissions.permission_id = permissions.id
+WHERE user_permissions.user_id = $1
+ORDER BY permissions.code;
+
+-- name: AddPermissionForUser :execrows
+INSERT INTO user_permissions (user_id, permission_id)
+SELECT @user_id, permissions.id FROM permissions
+WHERE permissions.code = @code
+ON CONFLICT DO NOTHING;
+
+-- name: RemovePermissionForUser :execrows
+DELETE FROM user_permissions
+USING permissions
+WHERE user_permissions.permission_id = permissions.id
+  AND user_permissions.user_id = @user_id
+  AND permissions.code = @code;
@@ -6,13 +6,56 @@ package storage
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	ActivateUser(ctx context.Context, arg ActivateUserParams) (User, error)
+	AddPermissionForUser(ctx context.Context, arg AddPermissionForUserParams) (int64, error)
+	CreateGenre(ctx context.Context, arg CreateGenreParams) (Genre, error)
+	CreateGenreAliases(ctx context.Context, arg CreateGenreAliasesParams) error
 	CreateMovie(ctx context.Context, arg CreateMovieParams) (Movie, error)
+	CreateMovieCredit(ctx context.Context, arg CreateMovieCreditParams) (MovieCredit, error)
+	CreateMovieRevision(ctx context.Context, arg CreateMovieRevisionParams) error
+	CreateMovieRevisions(ctx context.Context, arg []CreateMovieRevisionsParams) (int64, error)
+	CreateMovies(ctx context.Context, arg []CreateMoviesParams) (int64, error)
+	CreatePerson(ctx context.Context, arg CreatePersonParams) (Person, error)
+	CreateToken(ctx context.Context, arg CreateTokenParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteGenre(ctx context.Context, slug string) (int64, error)
+	DeleteGenreAliases(ctx context.Context, genreID int64) error
+	DeleteMovie(ctx context.Context, id int64) (Movie, error)
+	DeleteMovieCredit(ctx context.Context, arg DeleteMovieCreditParams) (int64, error)
+	DeletePerson(ctx context.Context, id int64) (int64, error)
+	DeleteTokensForUser(ctx context.Context, arg DeleteTokensForUserParams) error
+	DeleteUser(ctx context.Context, id int64) error
+	ExportMovies(ctx context.Context, arg ExportMoviesParams) ([]Movie, error)
+	GetGenre(ctx context.Context, slug string) (GetGenreRow, error)
 	GetMovie(ctx context.Context, id int64) (Movie, error)
-	ListMovies(ctx context.Context) ([]Movie, error)
+	// The first revision of a version is the one which produced it, a delete or
+	// restore which followed keeps the version.
+	GetMovieRevision(ctx context.Context, arg GetMovieRevisionParams) (MovieRevision, error)
+	GetPermissionsForUser(ctx context.Context, userID int64) ([]string, error)
+	GetPerson(ctx context.Context, id int64) (Person, error)
+	GetUser(ctx context.Context, id int64) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserForToken(ctx context.Context, arg GetUserForTokenParams) (GetUserForTokenRow, error)
+	ListDeletedMovies(ctx context.Context) ([]Movie, error)
+	ListGenres(ctx context.Context) ([]ListGenresRow, error)
+	ListMovieCredits(ctx context.Context, movieID int64) ([]ListMovieCreditsRow, error)
+	ListMovieRevisions(ctx context.Context, movieID int64) ([]MovieRevision, error)
+	ListMovies(ctx context.Context, arg ListMoviesParams) ([]ListMoviesRow, error)
+	ListMoviesKeyset(ctx context.Context, arg ListMoviesKeysetParams) ([]Movie, error)
+	ListPeople(ctx context.Context, arg ListPeopleParams) ([]ListPeopleRow, error)
+	PurgeDeletedMovies(ctx context.Context, deletedBefore pgtype.Timestamptz) (int64, error)
+	RemovePermissionForUser(ctx context.Context, arg RemovePermissionForUserParams) (int64, error)
+	ReserveMovieIDs(ctx context.Context, count int32) ([]int64, error)
+	RestoreMovie(ctx context.Context, id int64) (Movie, error)
+	SearchMovies(ctx context.Context, arg SearchMoviesParams) ([]SearchMoviesRow, error)
+	UpdateGenre(ctx context.Context, arg UpdateGenreParams) (Genre, error)
 	UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie, error)
+	UpdatePerson(ctx context.Context, arg UpdatePersonParams) (Person, error)
 }
 
 var _ Querier = (*Queries)(nil)
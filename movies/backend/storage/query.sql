@@ -1,16 +1,138 @@
 -- name: ListMovies :many
-SELECT * FROM movies;
+SELECT count(*) OVER() AS total_records, movies.id, movies.created_at, movies.title, movies.year, movies.runtime_min, movies.genres, movies.version, movies.deleted_at
+FROM movies
+WHERE deleted_at IS NULL
+  AND (sqlc.arg(title)::text = '' OR title ILIKE '%' || sqlc.arg(title)::text || '%')
+  AND genres @> coalesce(sqlc.narg(genres)::text[], '{}')
+  AND (sqlc.arg(year_min)::int = 0 OR year >= sqlc.arg(year_min)::int)
+  AND (sqlc.arg(year_max)::int = 0 OR year <= sqlc.arg(year_max)::int)
+  AND (sqlc.arg(runtime_min)::int = 0 OR runtime_min >= sqlc.arg(runtime_min)::int)
+  AND (sqlc.arg(runtime_max)::int = 0 OR runtime_min <= sqlc.arg(runtime_max)::int)
+  AND (sqlc.arg(person_id)::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = sqlc.arg(person_id)::bigint
+  ))
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'title' THEN title END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-title' THEN title END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'year' THEN year END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-year' THEN year END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN sqlc.arg(sort)::text = '-id' THEN id END DESC,
+  id ASC
+LIMIT sqlc.arg(page_size)::int OFFSET sqlc.arg(page_offset)::int;
+
+-- name: ListMoviesKeyset :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NULL
+  AND (sqlc.arg(title)::text = '' OR title ILIKE '%' || sqlc.arg(title)::text || '%')
+  AND genres @> coalesce(sqlc.narg(genres)::text[], '{}')
+  AND (sqlc.arg(year_min)::int = 0 OR year >= sqlc.arg(year_min)::int)
+  AND (sqlc.arg(year_max)::int = 0 OR year <= sqlc.arg(year_max)::int)
+  AND (sqlc.arg(runtime_min)::int = 0 OR runtime_min >= sqlc.arg(runtime_min)::int)
+  AND (sqlc.arg(runtime_max)::int = 0 OR runtime_min <= sqlc.arg(runtime_max)::int)
+  AND (sqlc.arg(person_id)::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = sqlc.arg(person_id)::bigint
+  ))
+  AND (
+    sqlc.arg(after_id)::bigint = 0
+    OR (sqlc.arg(sort)::text = 'id' AND id > sqlc.arg(after_id)::bigint)
+    OR (sqlc.arg(sort)::text = '-id' AND id < sqlc.arg(after_id)::bigint)
+    OR (sqlc.arg(sort)::text = 'title' AND (title, id) > (sqlc.arg(after_title)::text, sqlc.arg(after_id)::bigint))
+    OR (sqlc.arg(sort)::text = '-title' AND (title, id) < (sqlc.arg(after_title)::text, sqlc.arg(after_id)::bigint))
+    OR (sqlc.arg(sort)::text = 'year' AND (year, id) > (sqlc.arg(after_year)::int, sqlc.arg(after_id)::bigint))
+    OR (sqlc.arg(sort)::text = '-year' AND (year, id) < (sqlc.arg(after_year)::int, sqlc.arg(after_id)::bigint))
+    OR (sqlc.arg(sort)::text = 'runtime' AND (runtime_min, id) > (sqlc.arg(after_runtime)::int, sqlc.arg(after_id)::bigint))
+    OR (sqlc.arg(sort)::text = '-runtime' AND (runtime_min, id) < (sqlc.arg(after_runtime)::int, sqlc.arg(after_id)::bigint))
+  )
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'title' THEN title END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-title' THEN title END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'year' THEN year END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-year' THEN year END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN sqlc.arg(sort)::text LIKE '-%' THEN id END DESC,
+  id ASC
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: ExportMovies :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NULL
+  AND (sqlc.arg(title)::text = '' OR title ILIKE '%' || sqlc.arg(title)::text || '%')
+  AND genres @> coalesce(sqlc.narg(genres)::text[], '{}')
+  AND (sqlc.arg(year_min)::int = 0 OR year >= sqlc.arg(year_min)::int)
+  AND (sqlc.arg(year_max)::int = 0 OR year <= sqlc.arg(year_max)::int)
+  AND (sqlc.arg(runtime_min)::int = 0 OR runtime_min >= sqlc.arg(runtime_min)::int)
+  AND (sqlc.arg(runtime_max)::int = 0 OR runtime_min <= sqlc.arg(runtime_max)::int)
+  AND (sqlc.arg(person_id)::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = sqlc.arg(person_id)::bigint
+  ))
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'title' THEN title END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-title' THEN title END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'year' THEN year END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-year' THEN year END DESC,
+  CASE WHEN sqlc.arg(sort)::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN sqlc.arg(sort)::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN sqlc.arg(sort)::text = '-id' THEN id END DESC,
+  id ASC;
 
 -- name: CreateMovie :one
 INSERT INTO movies (title, year, runtime_min, genres)
-VALUES ($1, $2, $3, $4) RETURNING *;
+VALUES ($1, $2, $3, $4) RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at;
+
+-- name: CreateMovies :copyfrom
+INSERT INTO movies (id, title, year, runtime_min, genres)
+VALUES ($1, $2, $3, $4, $5);
 
 -- name: GetMovie :one
-SELECT * FROM movies
-WHERE id = $1;
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: UpdateMovie :one
 UPDATE movies
 SET title = $2, year = $3, runtime_min = $4, genres = $5, version = version + 1
-WHERE id = $1
-RETURNING *;
+WHERE id = $1 AND version = $6 AND deleted_at IS NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at;
+
+-- name: DeleteMovie :one
+UPDATE movies
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at;
+
+-- name: ListDeletedMovies :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: RestoreMovie :one
+UPDATE movies
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at;
+
+-- name: PurgeDeletedMovies :execrows
+DELETE FROM movies
+WHERE deleted_at IS NOT NULL AND deleted_at < sqlc.arg(deleted_before)::timestamptz;
+
+-- name: SearchMovies :many
+SELECT movies.id, movies.created_at, movies.title, movies.year, movies.runtime_min, movies.genres, movies.version, movies.deleted_at,
+  (ts_rank(search_vector, websearch_to_tsquery('english', sqlc.arg(query)::text))
+    + greatest(similarity(title, sqlc.arg(query)::text), word_similarity(sqlc.arg(query)::text, title)))::real AS rank,
+  ts_headline('english', replace(replace(replace(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+    websearch_to_tsquery('english', sqlc.arg(query)::text),
+    'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS snippet
+FROM movies
+WHERE deleted_at IS NULL
+  AND (
+    search_vector @@ websearch_to_tsquery('english', sqlc.arg(query)::text)
+    OR title % sqlc.arg(query)::text
+    OR sqlc.arg(query)::text <% title
+  )
+ORDER BY rank DESC, id ASC
+LIMIT sqlc.arg(page_size)::int;
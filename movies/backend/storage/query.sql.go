@@ -7,11 +7,13 @@ package storage
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createMovie = `-- name: CreateMovie :one
 INSERT INTO movies (title, year, runtime_min, genres)
-VALUES ($1, $2, $3, $4) RETURNING id, created_at, title, year, runtime_min, genres, version
+VALUES ($1, $2, $3, $4) RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at
 `
 
 type CreateMovieParams struct {
@@ -37,13 +39,118 @@ func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (Movie
 		&i.RuntimeMin,
 		&i.Genres,
 		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+type CreateMoviesParams struct {
+	ID         int64    `json:"id"`
+	Title      string   `json:"title"`
+	Year       int32    `json:"year"`
+	RuntimeMin int32    `json:"runtimeMin"`
+	Genres     []string `json:"genres"`
+}
+
+const deleteMovie = `-- name: DeleteMovie :one
+UPDATE movies
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at
+`
+
+func (q *Queries) DeleteMovie(ctx context.Context, id int64) (Movie, error) {
+	row := q.db.QueryRow(ctx, deleteMovie, id)
+	var i Movie
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Title,
+		&i.Year,
+		&i.RuntimeMin,
+		&i.Genres,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const exportMovies = `-- name: ExportMovies :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NULL
+  AND ($1::text = '' OR title ILIKE '%' || $1::text || '%')
+  AND genres @> coalesce($2::text[], '{}')
+  AND ($3::int = 0 OR year >= $3::int)
+  AND ($4::int = 0 OR year <= $4::int)
+  AND ($5::int = 0 OR runtime_min >= $5::int)
+  AND ($6::int = 0 OR runtime_min <= $6::int)
+  AND ($7::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = $7::bigint
+  ))
+ORDER BY
+  CASE WHEN $8::text = 'title' THEN title END ASC,
+  CASE WHEN $8::text = '-title' THEN title END DESC,
+  CASE WHEN $8::text = 'year' THEN year END ASC,
+  CASE WHEN $8::text = '-year' THEN year END DESC,
+  CASE WHEN $8::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN $8::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN $8::text = '-id' THEN id END DESC,
+  id ASC
+`
+
+type ExportMoviesParams struct {
+	Title      string   `json:"title"`
+	Genres     []string `json:"genres"`
+	YearMin    int32    `json:"yearMin"`
+	YearMax    int32    `json:"yearMax"`
+	RuntimeMin int32    `json:"runtimeMin"`
+	RuntimeMax int32    `json:"runtimeMax"`
+	PersonID   int64    `json:"personId"`
+	Sort       string   `json:"sort"`
+}
+
+func (q *Queries) ExportMovies(ctx context.Context, arg ExportMoviesParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, exportMovies,
+		arg.Title,
+		arg.Genres,
+		arg.YearMin,
+		arg.YearMax,
+		arg.RuntimeMin,
+		arg.RuntimeMax,
+		arg.PersonID,
+		arg.Sort,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Movie
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Title,
+			&i.Year,
+			&i.RuntimeMin,
+			&i.Genres,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMovie = `-- name: GetMovie :one
-SELECT id, created_at, title, year, runtime_min, genres, version FROM movies
-WHERE id = $1
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetMovie(ctx context.Context, id int64) (Movie, error) {
@@ -57,16 +164,199 @@ func (q *Queries) GetMovie(ctx context.Context, id int64) (Movie, error) {
 		&i.RuntimeMin,
 		&i.Genres,
 		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const listDeletedMovies = `-- name: ListDeletedMovies :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) ListDeletedMovies(ctx context.Context) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listDeletedMovies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Movie
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Title,
+			&i.Year,
+			&i.RuntimeMin,
+			&i.Genres,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMovies = `-- name: ListMovies :many
-SELECT id, created_at, title, year, runtime_min, genres, version FROM movies
+SELECT count(*) OVER() AS total_records, movies.id, movies.created_at, movies.title, movies.year, movies.runtime_min, movies.genres, movies.version, movies.deleted_at
+FROM movies
+WHERE deleted_at IS NULL
+  AND ($1::text = '' OR title ILIKE '%' || $1::text || '%')
+  AND genres @> coalesce($2::text[], '{}')
+  AND ($3::int = 0 OR year >= $3::int)
+  AND ($4::int = 0 OR year <= $4::int)
+  AND ($5::int = 0 OR runtime_min >= $5::int)
+  AND ($6::int = 0 OR runtime_min <= $6::int)
+  AND ($7::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = $7::bigint
+  ))
+ORDER BY
+  CASE WHEN $8::text = 'title' THEN title END ASC,
+  CASE WHEN $8::text = '-title' THEN title END DESC,
+  CASE WHEN $8::text = 'year' THEN year END ASC,
+  CASE WHEN $8::text = '-year' THEN year END DESC,
+  CASE WHEN $8::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN $8::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN $8::text = '-id' THEN id END DESC,
+  id ASC
+LIMIT $9::int OFFSET $10::int
 `
 
-func (q *Queries) ListMovies(ctx context.Context) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listMovies)
+type ListMoviesParams struct {
+	Title      string   `json:"title"`
+	Genres     []string `json:"genres"`
+	YearMin    int32    `json:"yearMin"`
+	YearMax    int32    `json:"yearMax"`
+	RuntimeMin int32    `json:"runtimeMin"`
+	RuntimeMax int32    `json:"runtimeMax"`
+	PersonID   int64    `json:"personId"`
+	Sort       string   `json:"sort"`
+	PageSize   int32    `json:"pageSize"`
+	PageOffset int32    `json:"pageOffset"`
+}
+
+type ListMoviesRow struct {
+	TotalRecords int64 `json:"totalRecords"`
+	Movie        Movie `json:"movie"`
+}
+
+func (q *Queries) ListMovies(ctx context.Context, arg ListMoviesParams) ([]ListMoviesRow, error) {
+	rows, err := q.db.Query(ctx, listMovies,
+		arg.Title,
+		arg.Genres,
+		arg.YearMin,
+		arg.YearMax,
+		arg.RuntimeMin,
+		arg.RuntimeMax,
+		arg.PersonID,
+		arg.Sort,
+		arg.PageSize,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMoviesRow
+	for rows.Next() {
+		var i ListMoviesRow
+		if err := rows.Scan(
+			&i.TotalRecords,
+			&i.Movie.ID,
+			&i.Movie.CreatedAt,
+			&i.Movie.Title,
+			&i.Movie.Year,
+			&i.Movie.RuntimeMin,
+			&i.Movie.Genres,
+			&i.Movie.Version,
+			&i.Movie.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMoviesKeyset = `-- name: ListMoviesKeyset :many
+SELECT id, created_at, title, year, runtime_min, genres, version, deleted_at FROM movies
+WHERE deleted_at IS NULL
+  AND ($1::text = '' OR title ILIKE '%' || $1::text || '%')
+  AND genres @> coalesce($2::text[], '{}')
+  AND ($3::int = 0 OR year >= $3::int)
+  AND ($4::int = 0 OR year <= $4::int)
+  AND ($5::int = 0 OR runtime_min >= $5::int)
+  AND ($6::int = 0 OR runtime_min <= $6::int)
+  AND ($7::bigint = 0 OR EXISTS (
+    SELECT 1 FROM movie_credits
+    WHERE movie_credits.movie_id = movies.id AND movie_credits.person_id = $7::bigint
+  ))
+  AND (
+    $8::bigint = 0
+    OR ($9::text = 'id' AND id > $8::bigint)
+    OR ($9::text = '-id' AND id < $8::bigint)
+    OR ($9::text = 'title' AND (title, id) > ($10::text, $8::bigint))
+    OR ($9::text = '-title' AND (title, id) < ($10::text, $8::bigint))
+    OR ($9::text = 'year' AND (year, id) > ($11::int, $8::bigint))
+    OR ($9::text = '-year' AND (year, id) < ($11::int, $8::bigint))
+    OR ($9::text = 'runtime' AND (runtime_min, id) > ($12::int, $8::bigint))
+    OR ($9::text = '-runtime' AND (runtime_min, id) < ($12::int, $8::bigint))
+  )
+ORDER BY
+  CASE WHEN $9::text = 'title' THEN title END ASC,
+  CASE WHEN $9::text = '-title' THEN title END DESC,
+  CASE WHEN $9::text = 'year' THEN year END ASC,
+  CASE WHEN $9::text = '-year' THEN year END DESC,
+  CASE WHEN $9::text = 'runtime' THEN runtime_min END ASC,
+  CASE WHEN $9::text = '-runtime' THEN runtime_min END DESC,
+  CASE WHEN $9::text LIKE '-%' THEN id END DESC,
+  id ASC
+LIMIT $13::int
+`
+
+type ListMoviesKeysetParams struct {
+	Title        string   `json:"title"`
+	Genres       []string `json:"genres"`
+	YearMin      int32    `json:"yearMin"`
+	YearMax      int32    `json:"yearMax"`
+	RuntimeMin   int32    `json:"runtimeMin"`
+	RuntimeMax   int32    `json:"runtimeMax"`
+	PersonID     int64    `json:"personId"`
+	AfterID      int64    `json:"afterId"`
+	Sort         string   `json:"sort"`
+	AfterTitle   string   `json:"afterTitle"`
+	AfterYear    int32    `json:"afterYear"`
+	AfterRuntime int32    `json:"afterRuntime"`
+	PageSize     int32    `json:"pageSize"`
+}
+
+func (q *Queries) ListMoviesKeyset(ctx context.Context, arg ListMoviesKeysetParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listMoviesKeyset,
+		arg.Title,
+		arg.Genres,
+		arg.YearMin,
+		arg.YearMax,
+		arg.RuntimeMin,
+		arg.RuntimeMax,
+		arg.PersonID,
+		arg.AfterID,
+		arg.Sort,
+		arg.AfterTitle,
+		arg.AfterYear,
+		arg.AfterRuntime,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +372,103 @@ func (q *Queries) ListMovies(ctx context.Context) ([]Movie, error) {
 			&i.RuntimeMin,
 			&i.Genres,
 			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedMovies = `-- name: PurgeDeletedMovies :execrows
+DELETE FROM movies
+WHERE deleted_at IS NOT NULL AND deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeDeletedMovies(ctx context.Context, deletedBefore pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedMovies, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreMovie = `-- name: RestoreMovie :one
+UPDATE movies
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at
+`
+
+func (q *Queries) RestoreMovie(ctx context.Context, id int64) (Movie, error) {
+	row := q.db.QueryRow(ctx, restoreMovie, id)
+	var i Movie
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Title,
+		&i.Year,
+		&i.RuntimeMin,
+		&i.Genres,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const searchMovies = `-- name: SearchMovies :many
+SELECT movies.id, movies.created_at, movies.title, movies.year, movies.runtime_min, movies.genres, movies.version, movies.deleted_at,
+  (ts_rank(search_vector, websearch_to_tsquery('english', $1::text))
+    + greatest(similarity(title, $1::text), word_similarity($1::text, title)))::real AS rank,
+  ts_headline('english', replace(replace(replace(title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+    websearch_to_tsquery('english', $1::text),
+    'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS snippet
+FROM movies
+WHERE deleted_at IS NULL
+  AND (
+    search_vector @@ websearch_to_tsquery('english', $1::text)
+    OR title % $1::text
+    OR $1::text <% title
+  )
+ORDER BY rank DESC, id ASC
+LIMIT $2::int
+`
+
+type SearchMoviesParams struct {
+	Query    string `json:"query"`
+	PageSize int32  `json:"pageSize"`
+}
+
+type SearchMoviesRow struct {
+	Movie   Movie   `json:"movie"`
+	Rank    float32 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+func (q *Queries) SearchMovies(ctx context.Context, arg SearchMoviesParams) ([]SearchMoviesRow, error) {
+	rows, err := q.db.Query(ctx, searchMovies, arg.Query, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMoviesRow
+	for rows.Next() {
+		var i SearchMoviesRow
+		if err := rows.Scan(
+			&i.Movie.ID,
+			&i.Movie.CreatedAt,
+			&i.Movie.Title,
+			&i.Movie.Year,
+			&i.Movie.RuntimeMin,
+			&i.Movie.Genres,
+			&i.Movie.Version,
+			&i.Movie.DeletedAt,
+			&i.Rank,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -96,8 +483,8 @@ func (q *Queries) ListMovies(ctx context.Context) ([]Movie, error) {
 const updateMovie = `-- name: UpdateMovie :one
 UPDATE movies
 SET title = $2, year = $3, runtime_min = $4, genres = $5, version = version + 1
-WHERE id = $1
-RETURNING id, created_at, title, year, runtime_min, genres, version
+WHERE id = $1 AND version = $6 AND deleted_at IS NULL
+RETURNING id, created_at, title, year, runtime_min, genres, version, deleted_at
 `
 
 type UpdateMovieParams struct {
@@ -106,6 +493,7 @@ type UpdateMovieParams struct {
 	Year       int32    `json:"year"`
 	RuntimeMin int32    `json:"runtimeMin"`
 	Genres     []string `json:"genres"`
+	Version    int32    `json:"version"`
 }
 
 func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie, error) {
@@ -115,6 +503,7 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie
 		arg.Year,
 		arg.RuntimeMin,
 		arg.Genres,
+		arg.Version,
 	)
 	var i Movie
 	err := row.Scan(
@@ -125,6 +514,7 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie
 		&i.RuntimeMin,
 		&i.Genres,
 		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,24 @@
+-- name: CreateMovieRevision :exec
+INSERT INTO movie_revisions (movie_id, version, operation, title, year, runtime_min, genres, changed_fields, user_id, trace_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: CreateMovieRevisions :copyfrom
+INSERT INTO movie_revisions (movie_id, version, operation, title, year, runtime_min, genres, changed_fields, user_id, trace_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListMovieRevisions :many
+SELECT * FROM movie_revisions
+WHERE movie_id = $1
+ORDER BY id DESC;
+
+-- name: GetMovieRevision :one
+-- The first revision of a version is the one which produced it, a delete or
+-- restore which followed keeps the version.
+SELECT * FROM movie_revisions
+WHERE movie_id = $1 AND version = $2
+ORDER BY id
+LIMIT 1;
+
+-- name: ReserveMovieIDs :many
+SELECT nextval(pg_get_serial_sequence('movies', 'id'))::bigint AS id
+FROM generate_series(1, sqlc.arg(count)::int);
@@ -0,0 +1,95 @@
+// Package seed holds the sample movies loaded into development databases.
+package seed
+
+import (
+	"context"
+
+	"github.com/zbsss/greenlight/movies/backend/storage"
+)
+
+// Movies is a small catalogue of well-known movies.
+var Movies = []storage.CreateMovieParams{
+	{
+		Title:      "The Shawshank Redemption",
+		Year:       1994,
+		RuntimeMin: 142,
+		Genres:     []string{"drama"},
+	},
+	{
+		Title:      "The Godfather",
+		Year:       1972,
+		RuntimeMin: 175,
+		Genres:     []string{"crime", "drama"},
+	},
+	{
+		Title:      "Pulp Fiction",
+		Year:       1994,
+		RuntimeMin: 154,
+		Genres:     []string{"crime", "drama"},
+	},
+	{
+		Title:      "The Dark Knight",
+		Year:       2008,
+		RuntimeMin: 152,
+		Genres:     []string{"action", "crime", "drama"},
+	},
+	{
+		Title:      "Fight Club",
+		Year:       1999,
+		RuntimeMin: 139,
+		Genres:     []string{"drama"},
+	},
+	{
+		Title:      "Casablanca",
+		Year:       1942,
+		RuntimeMin: 102,
+		Genres:     []string{"drama", "romance", "war"},
+	},
+	{
+		Title:      "Spirited Away",
+		Year:       2001,
+		RuntimeMin: 125,
+		Genres:     []string{"animation", "adventure", "family"},
+	},
+	{
+		Title:      "The Matrix",
+		Year:       1999,
+		RuntimeMin: 136,
+		Genres:     []string{"action", "sci-fi"},
+	},
+	{
+		Title:      "Alien",
+		Year:       1979,
+		RuntimeMin: 117,
+		Genres:     []string{"horror", "sci-fi"},
+	},
+	{
+		Title:      "Toy Story",
+		Year:       1995,
+		RuntimeMin: 81,
+		Genres:     []string{"animation", "comedy", "family"},
+	},
+	{
+		Title:      "Seven Samurai",
+		Year:       1954,
+		RuntimeMin: 207,
+		Genres:     []string{"action", "drama"},
+	},
+	{
+		Title:      "The Grand Budapest Hotel",
+		Year:       2014,
+		RuntimeMin: 99,
+		Genres:     []string{"comedy", "crime"},
+	},
+}
+
+// Run creates the sample movies.
+func Run(ctx context.Context, q storage.Querier) error {
+	for _, m := range Movies {
+		if _, err := q.CreateMovie(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
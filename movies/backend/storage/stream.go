@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// StreamMovies runs the ExportMovies query and yields the movies as they are
+// read from the connection, instead of collecting them in a slice. The
+// connection is held until the loop over the sequence ends. The rows are
+// scanned by position into Movie, the queries of movies select its columns
+// in order and leave out search_vector, which is only used to search.
+func (q *Queries) StreamMovies(ctx context.Context, arg ExportMoviesParams) iter.Seq2[Movie, error] {
+	return func(yield func(Movie, error) bool) {
+		rows, err := q.db.Query(ctx, exportMovies,
+			arg.Title,
+			arg.Genres,
+			arg.YearMin,
+			arg.YearMax,
+			arg.RuntimeMin,
+			arg.RuntimeMax,
+			arg.PersonID,
+			arg.Sort,
+		)
+		if err != nil {
+			yield(Movie{}, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			i, err := pgx.RowToStructByPos[Movie](rows)
+			if err != nil {
+				yield(Movie{}, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Movie{}, err)
+		}
+	}
+}
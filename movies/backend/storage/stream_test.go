@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5"
+)
+
+var errQueryRecorded = errors.New("query recorded")
+
+// queryRecorder records the arguments of queries instead of running them.
+type queryRecorder struct {
+	DBTX
+	args [][]any
+}
+
+func (db *queryRecorder) Query(_ context.Context, _ string, args ...any) (pgx.Rows, error) {
+	db.args = append(db.args, args)
+	return nil, errQueryRecorded
+}
+
+// StreamMovies passes the arguments of the generated ExportMovies by hand, so
+// they must be kept in the same order.
+func TestStreamMoviesArguments(t *testing.T) {
+	db := &queryRecorder{}
+	q := New(db)
+	arg := ExportMoviesParams{
+		Title:      "title",
+		Genres:     []string{"drama"},
+		YearMin:    1,
+		YearMax:    2,
+		RuntimeMin: 3,
+		RuntimeMax: 4,
+		PersonID:   5,
+		Sort:       "sort",
+	}
+
+	if _, err := q.ExportMovies(context.Background(), arg); !errors.Is(err, errQueryRecorded) {
+		t.Fatalf("expected the query to be recorded, got %v", err)
+	}
+	for _, err := range q.StreamMovies(context.Background(), arg) {
+		if !errors.Is(err, errQueryRecorded) {
+			t.Fatalf("expected the query to be recorded, got %v", err)
+		}
+	}
+
+	if len(db.args) != 2 {
+		t.Fatalf("expected two queries, got %d", len(db.args))
+	}
+	if diff := cmp.Diff(db.args[0], db.args[1]); diff != "" {
+		t.Errorf("StreamMovies arguments differ from ExportMovies (-want +got):\n%s", diff)
+	}
+}
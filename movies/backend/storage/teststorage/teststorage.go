@@ -6,15 +6,17 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/zbsss/greenlight/movies/backend/storage"
 	"github.com/zbsss/greenlight/movies/backend/storage/migrations"
+	"github.com/zbsss/greenlight/movies/backend/storage/seed"
 )
 
 type TestStorage struct {
-	storage.Querier
+	storage.Store
+	pool      *pgxpool.Pool
 	container *postgres.PostgresContainer
 }
 
@@ -33,21 +35,35 @@ func New(ctx context.Context) (*TestStorage, error) {
 		return nil, errors.Wrap(err, "failed to run migrations")
 	}
 
-	conn, err := pgx.Connect(ctx, connectionString)
+	poolConfig, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse connection string")
+	}
+	poolConfig.ConnConfig.Tracer = storage.NewQueryTracer()
+
+	// Use a pool so that the storage can be shared by concurrent requests.
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to connect to postgres")
 	}
 
-	q := storage.New(conn)
+	q := storage.NewStore(pool)
 
 	if err := seedMockData(ctx, q); err != nil {
+		pool.Close()
 		return nil, errors.Wrap(err, "failed to seed mock data")
 	}
 
-	return &TestStorage{q, pg}, nil
+	return &TestStorage{q, pool, pg}, nil
+}
+
+// DB returns the connection pool behind the storage.
+func (ts *TestStorage) DB() *pgxpool.Pool {
+	return ts.pool
 }
 
 func (ts *TestStorage) Close(ctx context.Context) error {
+	ts.pool.Close()
 	return ts.container.Terminate(ctx)
 }
 
@@ -67,62 +83,19 @@ func newContainer(ctx context.Context) (*postgres.PostgresContainer, error) {
 }
 
 func seedMockData(ctx context.Context, q storage.Querier) error {
-	movies := []struct {
-		title      string
-		year       int32
-		runtimeMin int32
-		genres     []string
-	}{
-		{
-			title:      "The Shawshank Redemption",
-			year:       1994,
-			runtimeMin: 142,
-			genres:     []string{"drama"},
-		},
-		{
-			title:      "The Godfather",
-			year:       1972,
-			runtimeMin: 175,
-			genres:     []string{"crime", "drama"},
-		},
-		{
-			title:      "Pulp Fiction",
-			year:       1994,
-			runtimeMin: 154,
-			genres:     []string{"crime", "drama"},
-		},
-		{
-			title:      "The Dark Knight",
-			year:       2008,
-			runtimeMin: 152,
-			genres:     []string{"action", "crime", "drama"},
-		},
-		{
-			title:      "Fight Club",
-			year:       1999,
-			runtimeMin: 139,
-			genres:     []string{"drama"},
-		},
-	}
-
-	for _, m := range movies {
-		_, err := q.CreateMovie(ctx, storage.CreateMovieParams{
-			Title:      m.title,
-			Year:       m.year,
-			RuntimeMin: m.runtimeMin,
-			Genres:     m.genres,
-		})
-		if err != nil {
-			return err
-		}
+	if err := seed.Run(ctx, q); err != nil {
+		return err
 	}
 
-	result, err := q.ListMovies(ctx)
+	result, err := q.ListMovies(ctx, storage.ListMoviesParams{
+		Sort:     "id",
+		PageSize: int32(len(seed.Movies)),
+	})
 	if err != nil {
 		return err
 	}
-	if len(result) != len(movies) {
-		return fmt.Errorf("expected %d movies, got %d", len(movies), len(result))
+	if len(result) != len(seed.Movies) {
+		return fmt.Errorf("expected %d movies, got %d", len(seed.Movies), len(result))
 	}
 
 	return nil
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/zbsss/greenlight/movies/backend/storage"
+
+// queryNameRX matches the "-- name: GetMovie :one" comment sqlc puts at the
+// top of every query.
+var queryNameRX = regexp.MustCompile(`^-- name: (\w+)`)
+
+// QueryTracer creates a span for every SQL statement and COPY, as a child of
+// the span in the query context. Set it as the Tracer of a pgx.ConnConfig.
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+var (
+	_ pgx.QueryTracer    = (*QueryTracer)(nil)
+	_ pgx.CopyFromTracer = (*QueryTracer)(nil)
+)
+
+// NewQueryTracer uses the global tracer provider, so spans are only exported
+// once a provider has been installed with otel.SetTracerProvider.
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{tracer: otel.Tracer(tracerName)}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := "query"
+	if m := queryNameRX.FindStringSubmatch(data.SQL); m != nil {
+		name = m[1]
+	}
+
+	ctx, _ = t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(name),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	endSpan(ctx, data.CommandTag, data.Err)
+}
+
+func (t *QueryTracer) TraceCopyFromStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	table := strings.Join(data.TableName, ".")
+
+	ctx, _ = t.tracer.Start(ctx, "COPY "+table,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName("COPY"),
+			semconv.DBCollectionName(table),
+		),
+	)
+
+	return ctx
+}
+
+func (t *QueryTracer) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	endSpan(ctx, data.CommandTag, data.Err)
+}
+
+func endSpan(ctx context.Context, commandTag pgconn.CommandTag, err error) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	// Not finding a row is an expected outcome, not a failure of the query.
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", commandTag.RowsAffected()))
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQueryTracer(t *testing.T) {
+	tests := []struct {
+		name       string
+		sql        string
+		err        error
+		wantName   string
+		wantStatus codes.Code
+	}{
+		{
+			name:     "sqlc query",
+			sql:      getMovie,
+			wantName: "GetMovie",
+		},
+		{
+			name:     "ad hoc query",
+			sql:      "SELECT 1",
+			wantName: "query",
+		},
+		{
+			name:     "no rows",
+			sql:      getMovie,
+			err:      pgx.ErrNoRows,
+			wantName: "GetMovie",
+		},
+		{
+			name:       "error",
+			sql:        createMovie,
+			err:        errors.New("connection reset"),
+			wantName:   "CreateMovie",
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			tracer := &QueryTracer{tracer: tp.Tracer(tracerName)}
+
+			parentCtx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+			ctx := tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{SQL: tt.sql})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1"), Err: tt.err})
+			parent.End()
+
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("expected 2 spans, got %d", len(spans))
+			}
+			span := spans[0]
+
+			if span.Name() != tt.wantName {
+				t.Errorf("expected span name %q, got %q", tt.wantName, span.Name())
+			}
+			if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+				t.Error("expected query span to be a child of the span in the context")
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("expected status %v, got %v", tt.wantStatus, span.Status().Code)
+			}
+		})
+	}
+}
+
+func TestQueryTracerCopyFrom(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := &QueryTracer{tracer: tp.Tracer(tracerName)}
+
+	ctx := tracer.TraceCopyFromStart(context.Background(), nil, pgx.TraceCopyFromStartData{
+		TableName:   pgx.Identifier{"movies"},
+		ColumnNames: []string{"title"},
+	})
+	tracer.TraceCopyFromEnd(ctx, nil, pgx.TraceCopyFromEndData{CommandTag: pgconn.NewCommandTag("COPY 3")})
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if name := spans[0].Name(); name != "COPY movies" {
+		t.Errorf("expected span name %q, got %q", "COPY movies", name)
+	}
+	for _, attr := range spans[0].Attributes() {
+		if attr.Key == "db.rows_affected" && attr.Value.AsInt64() != 3 {
+			t.Errorf("expected 3 rows affected, got %d", attr.Value.AsInt64())
+		}
+	}
+}
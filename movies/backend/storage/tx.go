@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	defaultTxMaxAttempts = 3
+	txRetryBaseDelay     = 10 * time.Millisecond
+)
+
+// Store is a Querier which can also run a unit of work in a transaction.
+type Store interface {
+	Querier
+
+	// InTx calls fn with a Querier bound to a new transaction. The
+	// transaction is committed when fn returns nil and rolled back
+	// otherwise. fn may be called more than once, see WithMaxAttempts, so
+	// it must not have side effects outside of the database.
+	InTx(ctx context.Context, fn func(q Querier) error, opts ...TxOption) error
+
+	// StreamMovies yields the movies matched by ExportMovies one at a time,
+	// for exports which are too large to be held in memory.
+	StreamMovies(ctx context.Context, arg ExportMoviesParams) iter.Seq2[Movie, error]
+}
+
+// TxBeginner is implemented by *pgxpool.Pool and *pgx.Conn.
+type TxBeginner interface {
+	DBTX
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+type txConfig struct {
+	isoLevel    pgx.TxIsoLevel
+	maxAttempts int
+}
+
+type TxOption func(*txConfig)
+
+// WithIsolationLevel sets the isolation level of the transaction. The
+// default is read committed.
+func WithIsolationLevel(level pgx.TxIsoLevel) TxOption {
+	return func(c *txConfig) {
+		c.isoLevel = level
+	}
+}
+
+// WithMaxAttempts sets how many times the transaction is attempted when it
+// fails with a serialization failure or a deadlock. The default is 3.
+func WithMaxAttempts(n int) TxOption {
+	return func(c *txConfig) {
+		c.maxAttempts = max(n, 1)
+	}
+}
+
+// PgStore is the Store backed by a PostgreSQL connection or pool.
+type PgStore struct {
+	*Queries
+	db TxBeginner
+}
+
+var _ Store = (*PgStore)(nil)
+
+func NewStore(db TxBeginner) *PgStore {
+	return &PgStore{Queries: New(db), db: db}
+}
+
+func (s *PgStore) InTx(ctx context.Context, fn func(q Querier) error, opts ...TxOption) error {
+	cfg := txConfig{
+		isoLevel:    pgx.ReadCommitted,
+		maxAttempts: defaultTxMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := s.runTx(ctx, cfg.isoLevel, fn)
+		if err == nil || !IsRetryable(err) || attempt >= cfg.maxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(txRetryDelay(attempt)):
+		}
+	}
+}
+
+func (s *PgStore) runTx(ctx context.Context, isoLevel pgx.TxIsoLevel, fn func(q Querier) error) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: isoLevel})
+	if err != nil {
+		return err
+	}
+	// Rolling back a committed transaction is a no-op, this only takes
+	// effect when fn fails or panics.
+	defer func() {
+		_ = tx.Rollback(context.WithoutCancel(ctx))
+	}()
+
+	if err := fn(s.WithTx(tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// IsRetryable reports whether err is a serialization failure or a deadlock,
+// after which the whole transaction can be retried.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	switch pgErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	}
+
+	return false
+}
+
+// txRetryDelay backs off exponentially with full jitter, so that the
+// transactions which conflicted are unlikely to conflict again.
+func txRetryDelay(attempt int) time.Duration {
+	return rand.N(txRetryBaseDelay << (attempt - 1))
+}
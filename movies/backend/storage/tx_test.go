@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeTx records how the transaction ended. The embedded pgx.Tx is nil, the
+// tests never run queries on it.
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	rolledBack bool
+	commitErr  error
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.committed = true
+	return tx.commitErr
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	if !tx.committed {
+		tx.rolledBack = true
+	}
+	return nil
+}
+
+type fakeBeginner struct {
+	DBTX
+	txs       []*fakeTx
+	isoLevels []pgx.TxIsoLevel
+	commitErr error
+}
+
+func (db *fakeBeginner) BeginTx(_ context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	tx := &fakeTx{commitErr: db.commitErr}
+	db.txs = append(db.txs, tx)
+	db.isoLevels = append(db.isoLevels, opts.IsoLevel)
+	return tx, nil
+}
+
+func TestInTx(t *testing.T) {
+	errFailed := errors.New("failed")
+	serializationFailure := &pgconn.PgError{Code: "40001"}
+	deadlock := &pgconn.PgError{Code: "40P01"}
+
+	tests := []struct {
+		name          string
+		opts          []TxOption
+		errs          []error
+		commitErr     error
+		wantErr       error
+		wantAttempts  int
+		wantCommitted bool
+		wantIsoLevel  pgx.TxIsoLevel
+	}{
+		{
+			name:          "commit",
+			errs:          []error{nil},
+			wantAttempts:  1,
+			wantCommitted: true,
+			wantIsoLevel:  pgx.ReadCommitted,
+		},
+		{
+			name:         "rollback",
+			errs:         []error{errFailed},
+			wantErr:      errFailed,
+			wantAttempts: 1,
+			wantIsoLevel: pgx.ReadCommitted,
+		},
+		{
+			name:          "retry serialization failure",
+			opts:          []TxOption{WithIsolationLevel(pgx.Serializable)},
+			errs:          []error{serializationFailure, nil},
+			wantAttempts:  2,
+			wantCommitted: true,
+			wantIsoLevel:  pgx.Serializable,
+		},
+		{
+			name:          "retry deadlock",
+			errs:          []error{deadlock, deadlock, nil},
+			wantAttempts:  3,
+			wantCommitted: true,
+			wantIsoLevel:  pgx.ReadCommitted,
+		},
+		{
+			name:         "give up after max attempts",
+			opts:         []TxOption{WithMaxAttempts(2)},
+			errs:         []error{deadlock, deadlock, nil},
+			wantErr:      deadlock,
+			wantAttempts: 2,
+			wantIsoLevel: pgx.ReadCommitted,
+		},
+		{
+			name:          "failed commit",
+			opts:          []TxOption{WithIsolationLevel(pgx.RepeatableRead), WithMaxAttempts(1)},
+			errs:          []error{nil},
+			commitErr:     serializationFailure,
+			wantErr:       serializationFailure,
+			wantAttempts:  1,
+			wantCommitted: true,
+			wantIsoLevel:  pgx.RepeatableRead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &fakeBeginner{commitErr: tt.commitErr}
+			s := NewStore(db)
+
+			attempts := 0
+			err := s.InTx(context.Background(), func(q Querier) error {
+				err := tt.errs[attempts]
+				attempts++
+				return err
+			}, tt.opts...)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v; got %v", tt.wantErr, err)
+			}
+			if attempts != tt.wantAttempts || len(db.txs) != tt.wantAttempts {
+				t.Fatalf("expected %d attempts; got %d in %d transactions", tt.wantAttempts, attempts, len(db.txs))
+			}
+
+			last := db.txs[len(db.txs)-1]
+			if last.committed != tt.wantCommitted {
+				t.Errorf("expected committed %t; got %t", tt.wantCommitted, last.committed)
+			}
+			for i, tx := range db.txs[:len(db.txs)-1] {
+				if !tx.rolledBack {
+					t.Errorf("expected attempt %d to be rolled back", i+1)
+				}
+			}
+			if db.isoLevels[0] != tt.wantIsoLevel {
+				t.Errorf("expected isolation level %q; got %q", tt.wantIsoLevel, db.isoLevels[0])
+			}
+		})
+	}
+}
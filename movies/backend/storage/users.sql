@@ -0,0 +1,36 @@
+-- name: CreateUser :one
+INSERT INTO users (name, email, password_hash)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1;
+
+-- name: ActivateUser :one
+UPDATE users
+SET activated = true, version = version + 1
+WHERE id = $1 AND version = $2
+RETURNING *;
+
+-- name: CreateToken :exec
+INSERT INTO tokens (hash, user_id, expiry, scope)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetUserForToken :one
+SELECT sqlc.embed(users)
+FROM users
+INNER JOIN tokens ON users.id = tokens.user_id
+WHERE tokens.hash = $1 AND tokens.scope = $2 AND tokens.expiry > NOW();
+
+-- name: DeleteTokensForUser :exec
+DELETE FROM tokens
+WHERE scope = $1 AND user_id = $2;
@@ -0,0 +1,6224 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for CreditRole.
+const (
+	Actor           CreditRole = "actor"
+	Cinematographer CreditRole = "cinematographer"
+	Composer        CreditRole = "composer"
+	Director        CreditRole = "director"
+	Editor          CreditRole = "editor"
+	Producer        CreditRole = "producer"
+	Writer          CreditRole = "writer"
+)
+
+// Defines values for GrantPermissionRequestCode.
+const (
+	MoviesWrite      GrantPermissionRequestCode = "movies:write"
+	PermissionsAdmin GrantPermissionRequestCode = "permissions:admin"
+)
+
+// Defines values for ImportReportMode.
+const (
+	ImportReportModeAllOrNothing ImportReportMode = "all_or_nothing"
+	ImportReportModeBestEffort   ImportReportMode = "best_effort"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	Accepted ImportRowStatus = "accepted"
+	Rejected ImportRowStatus = "rejected"
+)
+
+// Defines values for MovieRevisionOperation.
+const (
+	Create   MovieRevisionOperation = "create"
+	Delete   MovieRevisionOperation = "delete"
+	Restore  MovieRevisionOperation = "restore"
+	Revert   MovieRevisionOperation = "revert"
+	Snapshot MovieRevisionOperation = "snapshot"
+	Update   MovieRevisionOperation = "update"
+)
+
+// Defines values for ProblemCode.
+const (
+	ProblemCodeAuthenticationRequired ProblemCode = "authentication_required"
+	ProblemCodeBadRequest             ProblemCode = "bad_request"
+	ProblemCodeConflict               ProblemCode = "conflict"
+	ProblemCodeEditConflict           ProblemCode = "edit_conflict"
+	ProblemCodeForbidden              ProblemCode = "forbidden"
+	ProblemCodeInactiveAccount        ProblemCode = "inactive_account"
+	ProblemCodeInternalError          ProblemCode = "internal_error"
+	ProblemCodeInvalidCredentials     ProblemCode = "invalid_credentials"
+	ProblemCodeInvalidToken           ProblemCode = "invalid_token"
+	ProblemCodeMethodNotAllowed       ProblemCode = "method_not_allowed"
+	ProblemCodeNotAcceptable          ProblemCode = "not_acceptable"
+	ProblemCodeNotFound               ProblemCode = "not_found"
+	ProblemCodeRateLimitExceeded      ProblemCode = "rate_limit_exceeded"
+	ProblemCodeUnsupportedMediaType   ProblemCode = "unsupported_media_type"
+	ProblemCodeValidationFailed       ProblemCode = "validation_failed"
+)
+
+// Defines values for GetV1MoviesParamsSort.
+const (
+	GetV1MoviesParamsSortId           GetV1MoviesParamsSort = "id"
+	GetV1MoviesParamsSortMinusId      GetV1MoviesParamsSort = "-id"
+	GetV1MoviesParamsSortMinusRuntime GetV1MoviesParamsSort = "-runtime"
+	GetV1MoviesParamsSortMinusTitle   GetV1MoviesParamsSort = "-title"
+	GetV1MoviesParamsSortMinusYear    GetV1MoviesParamsSort = "-year"
+	GetV1MoviesParamsSortRuntime      GetV1MoviesParamsSort = "runtime"
+	GetV1MoviesParamsSortTitle        GetV1MoviesParamsSort = "title"
+	GetV1MoviesParamsSortYear         GetV1MoviesParamsSort = "year"
+)
+
+// Defines values for GetV1MoviesParamsPagination.
+const (
+	Keyset GetV1MoviesParamsPagination = "keyset"
+	Offset GetV1MoviesParamsPagination = "offset"
+)
+
+// Defines values for GetV1MoviesExportParamsSort.
+const (
+	GetV1MoviesExportParamsSortId           GetV1MoviesExportParamsSort = "id"
+	GetV1MoviesExportParamsSortMinusId      GetV1MoviesExportParamsSort = "-id"
+	GetV1MoviesExportParamsSortMinusRuntime GetV1MoviesExportParamsSort = "-runtime"
+	GetV1MoviesExportParamsSortMinusTitle   GetV1MoviesExportParamsSort = "-title"
+	GetV1MoviesExportParamsSortMinusYear    GetV1MoviesExportParamsSort = "-year"
+	GetV1MoviesExportParamsSortRuntime      GetV1MoviesExportParamsSort = "runtime"
+	GetV1MoviesExportParamsSortTitle        GetV1MoviesExportParamsSort = "title"
+	GetV1MoviesExportParamsSortYear         GetV1MoviesExportParamsSort = "year"
+)
+
+// Defines values for PostV1MoviesImportParamsMode.
+const (
+	PostV1MoviesImportParamsModeAllOrNothing PostV1MoviesImportParamsMode = "all_or_nothing"
+	PostV1MoviesImportParamsModeBestEffort   PostV1MoviesImportParamsMode = "best_effort"
+)
+
+// ActivateUserRequest defines model for ActivateUserRequest.
+type ActivateUserRequest struct {
+	Token string `json:"token"`
+}
+
+// AuthenticationToken defines model for AuthenticationToken.
+type AuthenticationToken struct {
+	Expiry time.Time `json:"expiry"`
+
+	// Token Send as "Authorization: Bearer <token>"
+	Token string `json:"token"`
+}
+
+// CreateAuthenticationTokenRequest defines model for CreateAuthenticationTokenRequest.
+type CreateAuthenticationTokenRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// CreateCreditRequest defines model for CreateCreditRequest.
+type CreateCreditRequest struct {
+	// BillingOrder Position among the credits of the movie, after the existing credits when omitted
+	BillingOrder *int32 `json:"billingOrder,omitempty"`
+
+	// Character Name of the character, only for actors
+	Character *string    `json:"character,omitempty"`
+	PersonId  int64      `json:"personId"`
+	Role      CreditRole `json:"role"`
+}
+
+// CreateGenreRequest defines model for CreateGenreRequest.
+type CreateGenreRequest struct {
+	// Aliases Other spellings of the genre, stored as slugs
+	Aliases *[]string `json:"aliases,omitempty"`
+	Name    string    `json:"name"`
+	Slug    string    `json:"slug"`
+}
+
+// CreateMovieRequest defines model for CreateMovieRequest.
+type CreateMovieRequest struct {
+	// Genres Slugs or aliases of genres in the catalogue, stored as slugs
+	Genres     []string `json:"genres"`
+	RuntimeMin int32    `json:"runtimeMin"`
+	Title      string   `json:"title"`
+
+	// Year Must not be in the future
+	Year int32 `json:"year"`
+}
+
+// CreatePersonRequest defines model for CreatePersonRequest.
+type CreatePersonRequest struct {
+	// BirthYear Must not be in the future
+	BirthYear *int32 `json:"birthYear,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Credit defines model for Credit.
+type Credit struct {
+	BillingOrder int32 `json:"billingOrder"`
+
+	// Character Name of the character, only set for actors
+	Character  *string    `json:"character,omitempty"`
+	Id         int64      `json:"id"`
+	PersonId   int64      `json:"personId"`
+	PersonName string     `json:"personName"`
+	Role       CreditRole `json:"role"`
+}
+
+// CreditRole defines model for CreditRole.
+type CreditRole string
+
+// Genre defines model for Genre.
+type Genre struct {
+	// Aliases Other spellings which are stored as the slug when movies are written
+	Aliases []string `json:"aliases"`
+
+	// Name Display name
+	Name string `json:"name"`
+
+	// Slug Identifies the genre in the genres of movies
+	Slug string `json:"slug"`
+}
+
+// GrantPermissionRequest defines model for GrantPermissionRequest.
+type GrantPermissionRequest struct {
+	Code GrantPermissionRequestCode `json:"code"`
+}
+
+// GrantPermissionRequestCode defines model for GrantPermissionRequest.Code.
+type GrantPermissionRequestCode string
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Accepted Number of valid rows
+	Accepted int `json:"accepted"`
+
+	// Imported Number of movies inserted
+	Imported int              `json:"imported"`
+	Mode     ImportReportMode `json:"mode"`
+
+	// Rejected Number of invalid rows
+	Rejected int         `json:"rejected"`
+	Rows     []ImportRow `json:"rows"`
+}
+
+// ImportReportMode defines model for ImportReport.Mode.
+type ImportReportMode string
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	// Errors Invalid fields mapped to what is wrong with them, "row" when the row could not be parsed at all
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Line Line of the body the row starts on
+	Line   int             `json:"line"`
+	Status ImportRowStatus `json:"status"`
+}
+
+// ImportRowStatus defines model for ImportRow.Status.
+type ImportRowStatus string
+
+// Metadata Pagination details, empty when there are no results
+type Metadata struct {
+	CurrentPage *int32 `json:"current_page,omitempty"`
+	FirstPage   *int32 `json:"first_page,omitempty"`
+	LastPage    *int32 `json:"last_page,omitempty"`
+
+	// NextCursor Cursor for the next keyset page, omitted on the last page
+	NextCursor   *string `json:"next_cursor,omitempty"`
+	PageSize     *int32  `json:"page_size,omitempty"`
+	TotalRecords *int64  `json:"total_records,omitempty"`
+}
+
+// Movie defines model for Movie.
+type Movie struct {
+	// DeletedAt Time the movie was moved to the trash, only set for deleted movies
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Genres    []string   `json:"genres"`
+	Id        int64      `json:"id"`
+
+	// Runtime Runtime in minutes, formatted as "X min"
+	Runtime string `json:"runtime"`
+	Title   string `json:"title"`
+	Version int32  `json:"version"`
+
+	// Year Must not be in the future
+	Year int32 `json:"year"`
+}
+
+// MovieRevision defines model for MovieRevision.
+type MovieRevision struct {
+	// ChangedFields Fields of the movie changed by the revision
+	ChangedFields []string  `json:"changedFields"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Movie         Movie     `json:"movie"`
+
+	// Operation The change which produced the revision, snapshot is the state of movies which existed before the history was recorded
+	Operation MovieRevisionOperation `json:"operation"`
+
+	// TraceId Trace ID of the request which made the change
+	TraceId string `json:"traceId"`
+
+	// UserId User who made the change, not set for changes made outside of the API
+	UserId  *int64 `json:"userId,omitempty"`
+	Version int32  `json:"version"`
+}
+
+// MovieRevisionOperation The change which produced the revision, snapshot is the state of movies which existed before the history was recorded
+type MovieRevisionOperation string
+
+// PermissionsResponse defines model for PermissionsResponse.
+type PermissionsResponse struct {
+	Permissions []string `json:"permissions"`
+}
+
+// Person defines model for Person.
+type Person struct {
+	// BirthYear Omitted when the birth year is unknown
+	BirthYear *int32 `json:"birthYear,omitempty"`
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+}
+
+// Problem RFC 9457 problem details
+type Problem struct {
+	// Code Stable machine-readable error code
+	Code ProblemCode `json:"code"`
+
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Invalid fields mapped to what is wrong with them, only set for validation_failed
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Instance Trace ID of the request, for finding it in the logs
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI identifying the problem type, derived from the code
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable machine-readable error code
+type ProblemCode string
+
+// RegisterUserRequest defines model for RegisterUserRequest.
+type RegisterUserRequest struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Movie Movie `json:"movie"`
+
+	// Score Relevance of the movie to the query, higher is better
+	Score float32 `json:"score"`
+
+	// Snippet HTML fragment of the title with the matching words wrapped in <mark> tags. The title is HTML-escaped, so the fragment can be rendered as HTML.
+	Snippet string `json:"snippet"`
+}
+
+// UpdateGenreRequest defines model for UpdateGenreRequest.
+type UpdateGenreRequest struct {
+	// Aliases Replaces all the aliases of the genre
+	Aliases *[]string `json:"aliases,omitempty"`
+	Name    *string   `json:"name,omitempty"`
+}
+
+// UpdateMovieRequest defines model for UpdateMovieRequest.
+type UpdateMovieRequest struct {
+	// Genres Slugs or aliases of genres in the catalogue, stored as slugs
+	Genres     *[]string `json:"genres,omitempty"`
+	RuntimeMin *int32    `json:"runtimeMin,omitempty"`
+	Title      *string   `json:"title,omitempty"`
+
+	// Year Must not be in the future
+	Year *int32 `json:"year,omitempty"`
+}
+
+// UpdatePersonRequest defines model for UpdatePersonRequest.
+type UpdatePersonRequest struct {
+	// BirthYear Must not be in the future
+	BirthYear *int32 `json:"birthYear,omitempty"`
+
+	// ClearBirthYear Removes the birth year of the person, can not be combined with birthYear
+	ClearBirthYear *bool   `json:"clearBirthYear,omitempty"`
+	Name           *string `json:"name,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Activated bool      `json:"activated"`
+	CreatedAt time.Time `json:"createdAt"`
+	Email     string    `json:"email"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// Error RFC 9457 problem details
+type Error = Problem
+
+// Forbidden RFC 9457 problem details
+type Forbidden = Problem
+
+// Unauthorized RFC 9457 problem details
+type Unauthorized = Problem
+
+// UnprocessableEntity RFC 9457 problem details
+type UnprocessableEntity = Problem
+
+// GetV1MoviesParams defines parameters for GetV1Movies.
+type GetV1MoviesParams struct {
+	// Title Only return movies whose title contains this value (case-insensitive)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+
+	// Genres Only return movies which have all of these genres, given by slug or alias
+	Genres  *[]string `form:"genres,omitempty" json:"genres,omitempty"`
+	YearMin *int32    `form:"year_min,omitempty" json:"year_min,omitempty"`
+	YearMax *int32    `form:"year_max,omitempty" json:"year_max,omitempty"`
+
+	// RuntimeMin Minimum runtime in minutes
+	RuntimeMin *int32 `form:"runtime_min,omitempty" json:"runtime_min,omitempty"`
+
+	// RuntimeMax Maximum runtime in minutes
+	RuntimeMax *int32 `form:"runtime_max,omitempty" json:"runtime_max,omitempty"`
+
+	// PersonId Only return movies on which this person is credited
+	PersonId *int64 `form:"person_id,omitempty" json:"person_id,omitempty"`
+
+	// Sort Sort order, prefix with "-" for descending
+	Sort     *GetV1MoviesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page     *int32                 `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int32                 `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Pagination Pagination mode. Keyset pagination ignores page numbers and instead
+	// returns a next_cursor which resumes the listing after the last movie.
+	Pagination *GetV1MoviesParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// Cursor Opaque next_cursor from a previous keyset page, implies keyset pagination
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetV1MoviesParamsSort defines parameters for GetV1Movies.
+type GetV1MoviesParamsSort string
+
+// GetV1MoviesParamsPagination defines parameters for GetV1Movies.
+type GetV1MoviesParamsPagination string
+
+// GetV1MoviesExportParams defines parameters for GetV1MoviesExport.
+type GetV1MoviesExportParams struct {
+	// Title Only export movies whose title contains this value (case-insensitive)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+
+	// Genres Only export movies which have all of these genres, given by slug or alias
+	Genres  *[]string `form:"genres,omitempty" json:"genres,omitempty"`
+	YearMin *int32    `form:"year_min,omitempty" json:"year_min,omitempty"`
+	YearMax *int32    `form:"year_max,omitempty" json:"year_max,omitempty"`
+
+	// RuntimeMin Minimum runtime in minutes
+	RuntimeMin *int32 `form:"runtime_min,omitempty" json:"runtime_min,omitempty"`
+
+	// RuntimeMax Maximum runtime in minutes
+	RuntimeMax *int32 `form:"runtime_max,omitempty" json:"runtime_max,omitempty"`
+
+	// PersonId Only export movies on which this person is credited
+	PersonId *int64 `form:"person_id,omitempty" json:"person_id,omitempty"`
+
+	// Sort Sort order, prefix with "-" for descending
+	Sort *GetV1MoviesExportParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetV1MoviesExportParamsSort defines parameters for GetV1MoviesExport.
+type GetV1MoviesExportParamsSort string
+
+// PostV1MoviesImportParams defines parameters for PostV1MoviesImport.
+type PostV1MoviesImportParams struct {
+	Mode *PostV1MoviesImportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// PostV1MoviesImportParamsMode defines parameters for PostV1MoviesImport.
+type PostV1MoviesImportParamsMode string
+
+// GetV1MoviesSearchParams defines parameters for GetV1MoviesSearch.
+type GetV1MoviesSearchParams struct {
+	Q     string `form:"q" json:"q"`
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchV1MoviesIdParams defines parameters for PatchV1MoviesId.
+type PatchV1MoviesIdParams struct {
+	// IfMatch ETag of the version the update is based on
+	IfMatch *string `json:"If-Match,omitempty"`
+
+	// XExpectedVersion Version the update is based on
+	XExpectedVersion *int32 `json:"X-Expected-Version,omitempty"`
+}
+
+// GetV1PeopleParams defines parameters for GetV1People.
+type GetV1PeopleParams struct {
+	// Name Only return people whose name contains this value (case-insensitive)
+	Name     *string `form:"name,omitempty" json:"name,omitempty"`
+	Page     *int32  `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// PostV1GenresJSONRequestBody defines body for PostV1Genres for application/json ContentType.
+type PostV1GenresJSONRequestBody = CreateGenreRequest
+
+// PatchV1GenresSlugJSONRequestBody defines body for PatchV1GenresSlug for application/json ContentType.
+type PatchV1GenresSlugJSONRequestBody = UpdateGenreRequest
+
+// PostV1MoviesJSONRequestBody defines body for PostV1Movies for application/json ContentType.
+type PostV1MoviesJSONRequestBody = CreateMovieRequest
+
+// PatchV1MoviesIdJSONRequestBody defines body for PatchV1MoviesId for application/json ContentType.
+type PatchV1MoviesIdJSONRequestBody = UpdateMovieRequest
+
+// PostV1MoviesIdCreditsJSONRequestBody defines body for PostV1MoviesIdCredits for application/json ContentType.
+type PostV1MoviesIdCreditsJSONRequestBody = CreateCreditRequest
+
+// PostV1PeopleJSONRequestBody defines body for PostV1People for application/json ContentType.
+type PostV1PeopleJSONRequestBody = CreatePersonRequest
+
+// PatchV1PeopleIdJSONRequestBody defines body for PatchV1PeopleId for application/json ContentType.
+type PatchV1PeopleIdJSONRequestBody = UpdatePersonRequest
+
+// PostV1TokensAuthenticationJSONRequestBody defines body for PostV1TokensAuthentication for application/json ContentType.
+type PostV1TokensAuthenticationJSONRequestBody = CreateAuthenticationTokenRequest
+
+// PostV1UsersJSONRequestBody defines body for PostV1Users for application/json ContentType.
+type PostV1UsersJSONRequestBody = RegisterUserRequest
+
+// PutV1UsersActivatedJSONRequestBody defines body for PutV1UsersActivated for application/json ContentType.
+type PutV1UsersActivatedJSONRequestBody = ActivateUserRequest
+
+// PostV1UsersIdPermissionsJSONRequestBody defines body for PostV1UsersIdPermissions for application/json ContentType.
+type PostV1UsersIdPermissionsJSONRequestBody = GrantPermissionRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetV1Genres request
+	GetV1Genres(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1GenresWithBody request with any body
+	PostV1GenresWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Genres(ctx context.Context, body PostV1GenresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1GenresSlug request
+	DeleteV1GenresSlug(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1GenresSlug request
+	GetV1GenresSlug(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV1GenresSlugWithBody request with any body
+	PatchV1GenresSlugWithBody(ctx context.Context, slug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV1GenresSlug(ctx context.Context, slug string, body PatchV1GenresSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Movies request
+	GetV1Movies(ctx context.Context, params *GetV1MoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1MoviesWithBody request with any body
+	PostV1MoviesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Movies(ctx context.Context, body PostV1MoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesExport request
+	GetV1MoviesExport(ctx context.Context, params *GetV1MoviesExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1MoviesImportWithBody request with any body
+	PostV1MoviesImportWithBody(ctx context.Context, params *PostV1MoviesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesSearch request
+	GetV1MoviesSearch(ctx context.Context, params *GetV1MoviesSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesTrash request
+	GetV1MoviesTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1MoviesId request
+	DeleteV1MoviesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesId request
+	GetV1MoviesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV1MoviesIdWithBody request with any body
+	PatchV1MoviesIdWithBody(ctx context.Context, id int64, params *PatchV1MoviesIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV1MoviesId(ctx context.Context, id int64, params *PatchV1MoviesIdParams, body PatchV1MoviesIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesIdCredits request
+	GetV1MoviesIdCredits(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1MoviesIdCreditsWithBody request with any body
+	PostV1MoviesIdCreditsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1MoviesIdCredits(ctx context.Context, id int64, body PostV1MoviesIdCreditsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1MoviesIdCreditsCreditId request
+	DeleteV1MoviesIdCreditsCreditId(ctx context.Context, id int64, creditId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesIdHistory request
+	GetV1MoviesIdHistory(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1MoviesIdHistoryVersion request
+	GetV1MoviesIdHistoryVersion(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1MoviesIdRestore request
+	PostV1MoviesIdRestore(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1MoviesIdRevertVersion request
+	PostV1MoviesIdRevertVersion(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1People request
+	GetV1People(ctx context.Context, params *GetV1PeopleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1PeopleWithBody request with any body
+	PostV1PeopleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1People(ctx context.Context, body PostV1PeopleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1PeopleId request
+	DeleteV1PeopleId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1PeopleId request
+	GetV1PeopleId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV1PeopleIdWithBody request with any body
+	PatchV1PeopleIdWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV1PeopleId(ctx context.Context, id int64, body PatchV1PeopleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TokensAuthenticationWithBody request with any body
+	PostV1TokensAuthenticationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TokensAuthentication(ctx context.Context, body PostV1TokensAuthenticationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1UsersWithBody request with any body
+	PostV1UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Users(ctx context.Context, body PostV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1UsersActivatedWithBody request with any body
+	PutV1UsersActivatedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV1UsersActivated(ctx context.Context, body PutV1UsersActivatedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1UsersIdPermissions request
+	GetV1UsersIdPermissions(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1UsersIdPermissionsWithBody request with any body
+	PostV1UsersIdPermissionsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1UsersIdPermissions(ctx context.Context, id int64, body PostV1UsersIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1UsersIdPermissionsCode request
+	DeleteV1UsersIdPermissionsCode(ctx context.Context, id int64, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetV1Genres(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GenresRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1GenresWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1GenresRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Genres(ctx context.Context, body PostV1GenresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1GenresRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1GenresSlug(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1GenresSlugRequest(c.Server, slug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1GenresSlug(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GenresSlugRequest(c.Server, slug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1GenresSlugWithBody(ctx context.Context, slug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1GenresSlugRequestWithBody(c.Server, slug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1GenresSlug(ctx context.Context, slug string, body PatchV1GenresSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1GenresSlugRequest(c.Server, slug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Movies(ctx context.Context, params *GetV1MoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Movies(ctx context.Context, body PostV1MoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesExport(ctx context.Context, params *GetV1MoviesExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesImportWithBody(ctx context.Context, params *PostV1MoviesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesSearch(ctx context.Context, params *GetV1MoviesSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesSearchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesTrashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1MoviesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1MoviesIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1MoviesIdWithBody(ctx context.Context, id int64, params *PatchV1MoviesIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1MoviesIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1MoviesId(ctx context.Context, id int64, params *PatchV1MoviesIdParams, body PatchV1MoviesIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1MoviesIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesIdCredits(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesIdCreditsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesIdCreditsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesIdCreditsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesIdCredits(ctx context.Context, id int64, body PostV1MoviesIdCreditsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesIdCreditsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1MoviesIdCreditsCreditId(ctx context.Context, id int64, creditId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1MoviesIdCreditsCreditIdRequest(c.Server, id, creditId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesIdHistory(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesIdHistoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1MoviesIdHistoryVersion(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MoviesIdHistoryVersionRequest(c.Server, id, version)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesIdRestore(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesIdRestoreRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1MoviesIdRevertVersion(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1MoviesIdRevertVersionRequest(c.Server, id, version)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1People(ctx context.Context, params *GetV1PeopleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1PeopleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1PeopleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1PeopleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1People(ctx context.Context, body PostV1PeopleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1PeopleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1PeopleId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1PeopleIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1PeopleId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1PeopleIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1PeopleIdWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1PeopleIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1PeopleId(ctx context.Context, id int64, body PatchV1PeopleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1PeopleIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1TokensAuthenticationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TokensAuthenticationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1TokensAuthentication(ctx context.Context, body PostV1TokensAuthenticationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TokensAuthenticationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Users(ctx context.Context, body PostV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UsersActivatedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UsersActivatedRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UsersActivated(ctx context.Context, body PutV1UsersActivatedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UsersActivatedRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1UsersIdPermissions(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UsersIdPermissionsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersIdPermissionsWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersIdPermissionsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersIdPermissions(ctx context.Context, id int64, body PostV1UsersIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersIdPermissionsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1UsersIdPermissionsCode(ctx context.Context, id int64, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1UsersIdPermissionsCodeRequest(c.Server, id, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetV1GenresRequest generates requests for GetV1Genres
+func NewGetV1GenresRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/genres")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1GenresRequest calls the generic PostV1Genres builder with application/json body
+func NewPostV1GenresRequest(server string, body PostV1GenresJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1GenresRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1GenresRequestWithBody generates requests for PostV1Genres with any type of body
+func NewPostV1GenresRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/genres")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1GenresSlugRequest generates requests for DeleteV1GenresSlug
+func NewDeleteV1GenresSlugRequest(server string, slug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/genres/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1GenresSlugRequest generates requests for GetV1GenresSlug
+func NewGetV1GenresSlugRequest(server string, slug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/genres/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1GenresSlugRequest calls the generic PatchV1GenresSlug builder with application/json body
+func NewPatchV1GenresSlugRequest(server string, slug string, body PatchV1GenresSlugJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1GenresSlugRequestWithBody(server, slug, "application/json", bodyReader)
+}
+
+// NewPatchV1GenresSlugRequestWithBody generates requests for PatchV1GenresSlug with any type of body
+func NewPatchV1GenresSlugRequestWithBody(server string, slug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/genres/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1MoviesRequest generates requests for GetV1Movies
+func NewGetV1MoviesRequest(server string, params *GetV1MoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Title != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Genres != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "genres", runtime.ParamLocationQuery, *params.Genres); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.YearMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year_min", runtime.ParamLocationQuery, *params.YearMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.YearMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year_max", runtime.ParamLocationQuery, *params.YearMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RuntimeMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runtime_min", runtime.ParamLocationQuery, *params.RuntimeMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RuntimeMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runtime_max", runtime.ParamLocationQuery, *params.RuntimeMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PersonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "person_id", runtime.ParamLocationQuery, *params.PersonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Pagination != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pagination", runtime.ParamLocationQuery, *params.Pagination); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1MoviesRequest calls the generic PostV1Movies builder with application/json body
+func NewPostV1MoviesRequest(server string, body PostV1MoviesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1MoviesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1MoviesRequestWithBody generates requests for PostV1Movies with any type of body
+func NewPostV1MoviesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1MoviesExportRequest generates requests for GetV1MoviesExport
+func NewGetV1MoviesExportRequest(server string, params *GetV1MoviesExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Title != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Genres != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "genres", runtime.ParamLocationQuery, *params.Genres); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.YearMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year_min", runtime.ParamLocationQuery, *params.YearMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.YearMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year_max", runtime.ParamLocationQuery, *params.YearMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RuntimeMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runtime_min", runtime.ParamLocationQuery, *params.RuntimeMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RuntimeMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runtime_max", runtime.ParamLocationQuery, *params.RuntimeMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PersonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "person_id", runtime.ParamLocationQuery, *params.PersonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1MoviesImportRequestWithBody generates requests for PostV1MoviesImport with any type of body
+func NewPostV1MoviesImportRequestWithBody(server string, params *PostV1MoviesImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1MoviesSearchRequest generates requests for GetV1MoviesSearch
+func NewGetV1MoviesSearchRequest(server string, params *GetV1MoviesSearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1MoviesTrashRequest generates requests for GetV1MoviesTrash
+func NewGetV1MoviesTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1MoviesIdRequest generates requests for DeleteV1MoviesId
+func NewDeleteV1MoviesIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1MoviesIdRequest generates requests for GetV1MoviesId
+func NewGetV1MoviesIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1MoviesIdRequest calls the generic PatchV1MoviesId builder with application/json body
+func NewPatchV1MoviesIdRequest(server string, id int64, params *PatchV1MoviesIdParams, body PatchV1MoviesIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1MoviesIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchV1MoviesIdRequestWithBody generates requests for PatchV1MoviesId with any type of body
+func NewPatchV1MoviesIdRequestWithBody(server string, id int64, params *PatchV1MoviesIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		if params.XExpectedVersion != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Expected-Version", runtime.ParamLocationHeader, *params.XExpectedVersion)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Expected-Version", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV1MoviesIdCreditsRequest generates requests for GetV1MoviesIdCredits
+func NewGetV1MoviesIdCreditsRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/credits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1MoviesIdCreditsRequest calls the generic PostV1MoviesIdCredits builder with application/json body
+func NewPostV1MoviesIdCreditsRequest(server string, id int64, body PostV1MoviesIdCreditsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1MoviesIdCreditsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostV1MoviesIdCreditsRequestWithBody generates requests for PostV1MoviesIdCredits with any type of body
+func NewPostV1MoviesIdCreditsRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/credits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1MoviesIdCreditsCreditIdRequest generates requests for DeleteV1MoviesIdCreditsCreditId
+func NewDeleteV1MoviesIdCreditsCreditIdRequest(server string, id int64, creditId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "creditId", runtime.ParamLocationPath, creditId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/credits/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1MoviesIdHistoryRequest generates requests for GetV1MoviesIdHistory
+func NewGetV1MoviesIdHistoryRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1MoviesIdHistoryVersionRequest generates requests for GetV1MoviesIdHistoryVersion
+func NewGetV1MoviesIdHistoryVersionRequest(server string, id int64, version int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/history/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1MoviesIdRestoreRequest generates requests for PostV1MoviesIdRestore
+func NewPostV1MoviesIdRestoreRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1MoviesIdRevertVersionRequest generates requests for PostV1MoviesIdRevertVersion
+func NewPostV1MoviesIdRevertVersionRequest(server string, id int64, version int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/movies/%s/revert/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1PeopleRequest generates requests for GetV1People
+func NewGetV1PeopleRequest(server string, params *GetV1PeopleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/people")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1PeopleRequest calls the generic PostV1People builder with application/json body
+func NewPostV1PeopleRequest(server string, body PostV1PeopleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1PeopleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1PeopleRequestWithBody generates requests for PostV1People with any type of body
+func NewPostV1PeopleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/people")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1PeopleIdRequest generates requests for DeleteV1PeopleId
+func NewDeleteV1PeopleIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/people/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1PeopleIdRequest generates requests for GetV1PeopleId
+func NewGetV1PeopleIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/people/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1PeopleIdRequest calls the generic PatchV1PeopleId builder with application/json body
+func NewPatchV1PeopleIdRequest(server string, id int64, body PatchV1PeopleIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV1PeopleIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPatchV1PeopleIdRequestWithBody generates requests for PatchV1PeopleId with any type of body
+func NewPatchV1PeopleIdRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/people/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1TokensAuthenticationRequest calls the generic PostV1TokensAuthentication builder with application/json body
+func NewPostV1TokensAuthenticationRequest(server string, body PostV1TokensAuthenticationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TokensAuthenticationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1TokensAuthenticationRequestWithBody generates requests for PostV1TokensAuthentication with any type of body
+func NewPostV1TokensAuthenticationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens/authentication")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1UsersRequest calls the generic PostV1Users builder with application/json body
+func NewPostV1UsersRequest(server string, body PostV1UsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1UsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1UsersRequestWithBody generates requests for PostV1Users with any type of body
+func NewPostV1UsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutV1UsersActivatedRequest calls the generic PutV1UsersActivated builder with application/json body
+func NewPutV1UsersActivatedRequest(server string, body PutV1UsersActivatedJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV1UsersActivatedRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutV1UsersActivatedRequestWithBody generates requests for PutV1UsersActivated with any type of body
+func NewPutV1UsersActivatedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/activated")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1UsersIdPermissionsRequest generates requests for GetV1UsersIdPermissions
+func NewGetV1UsersIdPermissionsRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1UsersIdPermissionsRequest calls the generic PostV1UsersIdPermissions builder with application/json body
+func NewPostV1UsersIdPermissionsRequest(server string, id int64, body PostV1UsersIdPermissionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1UsersIdPermissionsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostV1UsersIdPermissionsRequestWithBody generates requests for PostV1UsersIdPermissions with any type of body
+func NewPostV1UsersIdPermissionsRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1UsersIdPermissionsCodeRequest generates requests for DeleteV1UsersIdPermissionsCode
+func NewDeleteV1UsersIdPermissionsCodeRequest(server string, id int64, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/permissions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetV1GenresWithResponse request
+	GetV1GenresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GenresResponse, error)
+
+	// PostV1GenresWithBodyWithResponse request with any body
+	PostV1GenresWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1GenresResponse, error)
+
+	PostV1GenresWithResponse(ctx context.Context, body PostV1GenresJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1GenresResponse, error)
+
+	// DeleteV1GenresSlugWithResponse request
+	DeleteV1GenresSlugWithResponse(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*DeleteV1GenresSlugResponse, error)
+
+	// GetV1GenresSlugWithResponse request
+	GetV1GenresSlugWithResponse(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*GetV1GenresSlugResponse, error)
+
+	// PatchV1GenresSlugWithBodyWithResponse request with any body
+	PatchV1GenresSlugWithBodyWithResponse(ctx context.Context, slug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1GenresSlugResponse, error)
+
+	PatchV1GenresSlugWithResponse(ctx context.Context, slug string, body PatchV1GenresSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1GenresSlugResponse, error)
+
+	// GetV1MoviesWithResponse request
+	GetV1MoviesWithResponse(ctx context.Context, params *GetV1MoviesParams, reqEditors ...RequestEditorFn) (*GetV1MoviesResponse, error)
+
+	// PostV1MoviesWithBodyWithResponse request with any body
+	PostV1MoviesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesResponse, error)
+
+	PostV1MoviesWithResponse(ctx context.Context, body PostV1MoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1MoviesResponse, error)
+
+	// GetV1MoviesExportWithResponse request
+	GetV1MoviesExportWithResponse(ctx context.Context, params *GetV1MoviesExportParams, reqEditors ...RequestEditorFn) (*GetV1MoviesExportResponse, error)
+
+	// PostV1MoviesImportWithBodyWithResponse request with any body
+	PostV1MoviesImportWithBodyWithResponse(ctx context.Context, params *PostV1MoviesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesImportResponse, error)
+
+	// GetV1MoviesSearchWithResponse request
+	GetV1MoviesSearchWithResponse(ctx context.Context, params *GetV1MoviesSearchParams, reqEditors ...RequestEditorFn) (*GetV1MoviesSearchResponse, error)
+
+	// GetV1MoviesTrashWithResponse request
+	GetV1MoviesTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1MoviesTrashResponse, error)
+
+	// DeleteV1MoviesIdWithResponse request
+	DeleteV1MoviesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteV1MoviesIdResponse, error)
+
+	// GetV1MoviesIdWithResponse request
+	GetV1MoviesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdResponse, error)
+
+	// PatchV1MoviesIdWithBodyWithResponse request with any body
+	PatchV1MoviesIdWithBodyWithResponse(ctx context.Context, id int64, params *PatchV1MoviesIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1MoviesIdResponse, error)
+
+	PatchV1MoviesIdWithResponse(ctx context.Context, id int64, params *PatchV1MoviesIdParams, body PatchV1MoviesIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1MoviesIdResponse, error)
+
+	// GetV1MoviesIdCreditsWithResponse request
+	GetV1MoviesIdCreditsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdCreditsResponse, error)
+
+	// PostV1MoviesIdCreditsWithBodyWithResponse request with any body
+	PostV1MoviesIdCreditsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesIdCreditsResponse, error)
+
+	PostV1MoviesIdCreditsWithResponse(ctx context.Context, id int64, body PostV1MoviesIdCreditsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1MoviesIdCreditsResponse, error)
+
+	// DeleteV1MoviesIdCreditsCreditIdWithResponse request
+	DeleteV1MoviesIdCreditsCreditIdWithResponse(ctx context.Context, id int64, creditId int64, reqEditors ...RequestEditorFn) (*DeleteV1MoviesIdCreditsCreditIdResponse, error)
+
+	// GetV1MoviesIdHistoryWithResponse request
+	GetV1MoviesIdHistoryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdHistoryResponse, error)
+
+	// GetV1MoviesIdHistoryVersionWithResponse request
+	GetV1MoviesIdHistoryVersionWithResponse(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*GetV1MoviesIdHistoryVersionResponse, error)
+
+	// PostV1MoviesIdRestoreWithResponse request
+	PostV1MoviesIdRestoreWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostV1MoviesIdRestoreResponse, error)
+
+	// PostV1MoviesIdRevertVersionWithResponse request
+	PostV1MoviesIdRevertVersionWithResponse(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*PostV1MoviesIdRevertVersionResponse, error)
+
+	// GetV1PeopleWithResponse request
+	GetV1PeopleWithResponse(ctx context.Context, params *GetV1PeopleParams, reqEditors ...RequestEditorFn) (*GetV1PeopleResponse, error)
+
+	// PostV1PeopleWithBodyWithResponse request with any body
+	PostV1PeopleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1PeopleResponse, error)
+
+	PostV1PeopleWithResponse(ctx context.Context, body PostV1PeopleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1PeopleResponse, error)
+
+	// DeleteV1PeopleIdWithResponse request
+	DeleteV1PeopleIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteV1PeopleIdResponse, error)
+
+	// GetV1PeopleIdWithResponse request
+	GetV1PeopleIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1PeopleIdResponse, error)
+
+	// PatchV1PeopleIdWithBodyWithResponse request with any body
+	PatchV1PeopleIdWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1PeopleIdResponse, error)
+
+	PatchV1PeopleIdWithResponse(ctx context.Context, id int64, body PatchV1PeopleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1PeopleIdResponse, error)
+
+	// PostV1TokensAuthenticationWithBodyWithResponse request with any body
+	PostV1TokensAuthenticationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TokensAuthenticationResponse, error)
+
+	PostV1TokensAuthenticationWithResponse(ctx context.Context, body PostV1TokensAuthenticationJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TokensAuthenticationResponse, error)
+
+	// PostV1UsersWithBodyWithResponse request with any body
+	PostV1UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersResponse, error)
+
+	PostV1UsersWithResponse(ctx context.Context, body PostV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersResponse, error)
+
+	// PutV1UsersActivatedWithBodyWithResponse request with any body
+	PutV1UsersActivatedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersActivatedResponse, error)
+
+	PutV1UsersActivatedWithResponse(ctx context.Context, body PutV1UsersActivatedJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UsersActivatedResponse, error)
+
+	// GetV1UsersIdPermissionsWithResponse request
+	GetV1UsersIdPermissionsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1UsersIdPermissionsResponse, error)
+
+	// PostV1UsersIdPermissionsWithBodyWithResponse request with any body
+	PostV1UsersIdPermissionsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersIdPermissionsResponse, error)
+
+	PostV1UsersIdPermissionsWithResponse(ctx context.Context, id int64, body PostV1UsersIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersIdPermissionsResponse, error)
+
+	// DeleteV1UsersIdPermissionsCodeWithResponse request
+	DeleteV1UsersIdPermissionsCodeWithResponse(ctx context.Context, id int64, code string, reqEditors ...RequestEditorFn) (*DeleteV1UsersIdPermissionsCodeResponse, error)
+}
+
+type GetV1GenresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Genres *[]Genre `json:"genres,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GenresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GenresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1GenresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Genre *Genre `json:"genre,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1GenresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1GenresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1GenresSlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1GenresSlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1GenresSlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1GenresSlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Genre *Genre `json:"genre,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GenresSlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GenresSlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1GenresSlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Genre *Genre `json:"genre,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1GenresSlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1GenresSlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Metadata Pagination details, empty when there are no results
+		Metadata *Metadata `json:"metadata,omitempty"`
+		Movies   *[]Movie  `json:"movies,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1MoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Movie *Movie `json:"movie,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1MoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1MoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movies []Movie `json:"movies"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON406     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1MoviesImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Report ImportReport `json:"report"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	JSON422                   *struct {
+		Report ImportReport `json:"report"`
+	}
+	ApplicationproblemJSON422     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1MoviesImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1MoviesImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Results *[]SearchResult `json:"results,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movies *[]Movie `json:"movies,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1MoviesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1MoviesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1MoviesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movie *Movie `json:"movie,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1MoviesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movie *Movie `json:"movie,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1MoviesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1MoviesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesIdCreditsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Credits *[]Credit `json:"credits,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesIdCreditsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesIdCreditsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1MoviesIdCreditsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Credit *Credit `json:"credit,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1MoviesIdCreditsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1MoviesIdCreditsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1MoviesIdCreditsCreditIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1MoviesIdCreditsCreditIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1MoviesIdCreditsCreditIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Revisions *[]MovieRevision `json:"revisions,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1MoviesIdHistoryVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Revision *MovieRevision `json:"revision,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MoviesIdHistoryVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MoviesIdHistoryVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1MoviesIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movie *Movie `json:"movie,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1MoviesIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1MoviesIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1MoviesIdRevertVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Movie *Movie `json:"movie,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1MoviesIdRevertVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1MoviesIdRevertVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1PeopleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Metadata Pagination details, empty when there are no results
+		Metadata *Metadata `json:"metadata,omitempty"`
+		People   *[]Person `json:"people,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1PeopleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1PeopleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1PeopleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Person *Person `json:"person,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1PeopleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1PeopleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1PeopleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1PeopleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1PeopleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1PeopleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Person *Person `json:"person,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1PeopleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1PeopleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV1PeopleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Person *Person `json:"person,omitempty"`
+	}
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1PeopleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1PeopleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TokensAuthenticationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		AuthenticationToken *AuthenticationToken `json:"authentication_token,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TokensAuthenticationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TokensAuthenticationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV1UsersActivatedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1UsersActivatedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1UsersActivatedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1UsersIdPermissionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PermissionsResponse
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UsersIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UsersIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1UsersIdPermissionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PermissionsResponse
+	ApplicationproblemJSON400     *Problem
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1UsersIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1UsersIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1UsersIdPermissionsCodeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PermissionsResponse
+	ApplicationproblemJSON401     *Unauthorized
+	ApplicationproblemJSON403     *Forbidden
+	ApplicationproblemJSON404     *Problem
+	ApplicationproblemJSON422     *UnprocessableEntity
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1UsersIdPermissionsCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1UsersIdPermissionsCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetV1GenresWithResponse request returning *GetV1GenresResponse
+func (c *ClientWithResponses) GetV1GenresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GenresResponse, error) {
+	rsp, err := c.GetV1Genres(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GenresResponse(rsp)
+}
+
+// PostV1GenresWithBodyWithResponse request with arbitrary body returning *PostV1GenresResponse
+func (c *ClientWithResponses) PostV1GenresWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1GenresResponse, error) {
+	rsp, err := c.PostV1GenresWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1GenresResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1GenresWithResponse(ctx context.Context, body PostV1GenresJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1GenresResponse, error) {
+	rsp, err := c.PostV1Genres(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1GenresResponse(rsp)
+}
+
+// DeleteV1GenresSlugWithResponse request returning *DeleteV1GenresSlugResponse
+func (c *ClientWithResponses) DeleteV1GenresSlugWithResponse(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*DeleteV1GenresSlugResponse, error) {
+	rsp, err := c.DeleteV1GenresSlug(ctx, slug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1GenresSlugResponse(rsp)
+}
+
+// GetV1GenresSlugWithResponse request returning *GetV1GenresSlugResponse
+func (c *ClientWithResponses) GetV1GenresSlugWithResponse(ctx context.Context, slug string, reqEditors ...RequestEditorFn) (*GetV1GenresSlugResponse, error) {
+	rsp, err := c.GetV1GenresSlug(ctx, slug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GenresSlugResponse(rsp)
+}
+
+// PatchV1GenresSlugWithBodyWithResponse request with arbitrary body returning *PatchV1GenresSlugResponse
+func (c *ClientWithResponses) PatchV1GenresSlugWithBodyWithResponse(ctx context.Context, slug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1GenresSlugResponse, error) {
+	rsp, err := c.PatchV1GenresSlugWithBody(ctx, slug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1GenresSlugResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1GenresSlugWithResponse(ctx context.Context, slug string, body PatchV1GenresSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1GenresSlugResponse, error) {
+	rsp, err := c.PatchV1GenresSlug(ctx, slug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1GenresSlugResponse(rsp)
+}
+
+// GetV1MoviesWithResponse request returning *GetV1MoviesResponse
+func (c *ClientWithResponses) GetV1MoviesWithResponse(ctx context.Context, params *GetV1MoviesParams, reqEditors ...RequestEditorFn) (*GetV1MoviesResponse, error) {
+	rsp, err := c.GetV1Movies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesResponse(rsp)
+}
+
+// PostV1MoviesWithBodyWithResponse request with arbitrary body returning *PostV1MoviesResponse
+func (c *ClientWithResponses) PostV1MoviesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesResponse, error) {
+	rsp, err := c.PostV1MoviesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1MoviesWithResponse(ctx context.Context, body PostV1MoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1MoviesResponse, error) {
+	rsp, err := c.PostV1Movies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesResponse(rsp)
+}
+
+// GetV1MoviesExportWithResponse request returning *GetV1MoviesExportResponse
+func (c *ClientWithResponses) GetV1MoviesExportWithResponse(ctx context.Context, params *GetV1MoviesExportParams, reqEditors ...RequestEditorFn) (*GetV1MoviesExportResponse, error) {
+	rsp, err := c.GetV1MoviesExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesExportResponse(rsp)
+}
+
+// PostV1MoviesImportWithBodyWithResponse request with arbitrary body returning *PostV1MoviesImportResponse
+func (c *ClientWithResponses) PostV1MoviesImportWithBodyWithResponse(ctx context.Context, params *PostV1MoviesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesImportResponse, error) {
+	rsp, err := c.PostV1MoviesImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesImportResponse(rsp)
+}
+
+// GetV1MoviesSearchWithResponse request returning *GetV1MoviesSearchResponse
+func (c *ClientWithResponses) GetV1MoviesSearchWithResponse(ctx context.Context, params *GetV1MoviesSearchParams, reqEditors ...RequestEditorFn) (*GetV1MoviesSearchResponse, error) {
+	rsp, err := c.GetV1MoviesSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesSearchResponse(rsp)
+}
+
+// GetV1MoviesTrashWithResponse request returning *GetV1MoviesTrashResponse
+func (c *ClientWithResponses) GetV1MoviesTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1MoviesTrashResponse, error) {
+	rsp, err := c.GetV1MoviesTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesTrashResponse(rsp)
+}
+
+// DeleteV1MoviesIdWithResponse request returning *DeleteV1MoviesIdResponse
+func (c *ClientWithResponses) DeleteV1MoviesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteV1MoviesIdResponse, error) {
+	rsp, err := c.DeleteV1MoviesId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1MoviesIdResponse(rsp)
+}
+
+// GetV1MoviesIdWithResponse request returning *GetV1MoviesIdResponse
+func (c *ClientWithResponses) GetV1MoviesIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdResponse, error) {
+	rsp, err := c.GetV1MoviesId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesIdResponse(rsp)
+}
+
+// PatchV1MoviesIdWithBodyWithResponse request with arbitrary body returning *PatchV1MoviesIdResponse
+func (c *ClientWithResponses) PatchV1MoviesIdWithBodyWithResponse(ctx context.Context, id int64, params *PatchV1MoviesIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1MoviesIdResponse, error) {
+	rsp, err := c.PatchV1MoviesIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1MoviesIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1MoviesIdWithResponse(ctx context.Context, id int64, params *PatchV1MoviesIdParams, body PatchV1MoviesIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1MoviesIdResponse, error) {
+	rsp, err := c.PatchV1MoviesId(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1MoviesIdResponse(rsp)
+}
+
+// GetV1MoviesIdCreditsWithResponse request returning *GetV1MoviesIdCreditsResponse
+func (c *ClientWithResponses) GetV1MoviesIdCreditsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdCreditsResponse, error) {
+	rsp, err := c.GetV1MoviesIdCredits(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesIdCreditsResponse(rsp)
+}
+
+// PostV1MoviesIdCreditsWithBodyWithResponse request with arbitrary body returning *PostV1MoviesIdCreditsResponse
+func (c *ClientWithResponses) PostV1MoviesIdCreditsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1MoviesIdCreditsResponse, error) {
+	rsp, err := c.PostV1MoviesIdCreditsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesIdCreditsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1MoviesIdCreditsWithResponse(ctx context.Context, id int64, body PostV1MoviesIdCreditsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1MoviesIdCreditsResponse, error) {
+	rsp, err := c.PostV1MoviesIdCredits(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesIdCreditsResponse(rsp)
+}
+
+// DeleteV1MoviesIdCreditsCreditIdWithResponse request returning *DeleteV1MoviesIdCreditsCreditIdResponse
+func (c *ClientWithResponses) DeleteV1MoviesIdCreditsCreditIdWithResponse(ctx context.Context, id int64, creditId int64, reqEditors ...RequestEditorFn) (*DeleteV1MoviesIdCreditsCreditIdResponse, error) {
+	rsp, err := c.DeleteV1MoviesIdCreditsCreditId(ctx, id, creditId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1MoviesIdCreditsCreditIdResponse(rsp)
+}
+
+// GetV1MoviesIdHistoryWithResponse request returning *GetV1MoviesIdHistoryResponse
+func (c *ClientWithResponses) GetV1MoviesIdHistoryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1MoviesIdHistoryResponse, error) {
+	rsp, err := c.GetV1MoviesIdHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesIdHistoryResponse(rsp)
+}
+
+// GetV1MoviesIdHistoryVersionWithResponse request returning *GetV1MoviesIdHistoryVersionResponse
+func (c *ClientWithResponses) GetV1MoviesIdHistoryVersionWithResponse(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*GetV1MoviesIdHistoryVersionResponse, error) {
+	rsp, err := c.GetV1MoviesIdHistoryVersion(ctx, id, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MoviesIdHistoryVersionResponse(rsp)
+}
+
+// PostV1MoviesIdRestoreWithResponse request returning *PostV1MoviesIdRestoreResponse
+func (c *ClientWithResponses) PostV1MoviesIdRestoreWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostV1MoviesIdRestoreResponse, error) {
+	rsp, err := c.PostV1MoviesIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesIdRestoreResponse(rsp)
+}
+
+// PostV1MoviesIdRevertVersionWithResponse request returning *PostV1MoviesIdRevertVersionResponse
+func (c *ClientWithResponses) PostV1MoviesIdRevertVersionWithResponse(ctx context.Context, id int64, version int32, reqEditors ...RequestEditorFn) (*PostV1MoviesIdRevertVersionResponse, error) {
+	rsp, err := c.PostV1MoviesIdRevertVersion(ctx, id, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1MoviesIdRevertVersionResponse(rsp)
+}
+
+// GetV1PeopleWithResponse request returning *GetV1PeopleResponse
+func (c *ClientWithResponses) GetV1PeopleWithResponse(ctx context.Context, params *GetV1PeopleParams, reqEditors ...RequestEditorFn) (*GetV1PeopleResponse, error) {
+	rsp, err := c.GetV1People(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1PeopleResponse(rsp)
+}
+
+// PostV1PeopleWithBodyWithResponse request with arbitrary body returning *PostV1PeopleResponse
+func (c *ClientWithResponses) PostV1PeopleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1PeopleResponse, error) {
+	rsp, err := c.PostV1PeopleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1PeopleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1PeopleWithResponse(ctx context.Context, body PostV1PeopleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1PeopleResponse, error) {
+	rsp, err := c.PostV1People(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1PeopleResponse(rsp)
+}
+
+// DeleteV1PeopleIdWithResponse request returning *DeleteV1PeopleIdResponse
+func (c *ClientWithResponses) DeleteV1PeopleIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeleteV1PeopleIdResponse, error) {
+	rsp, err := c.DeleteV1PeopleId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1PeopleIdResponse(rsp)
+}
+
+// GetV1PeopleIdWithResponse request returning *GetV1PeopleIdResponse
+func (c *ClientWithResponses) GetV1PeopleIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1PeopleIdResponse, error) {
+	rsp, err := c.GetV1PeopleId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1PeopleIdResponse(rsp)
+}
+
+// PatchV1PeopleIdWithBodyWithResponse request with arbitrary body returning *PatchV1PeopleIdResponse
+func (c *ClientWithResponses) PatchV1PeopleIdWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1PeopleIdResponse, error) {
+	rsp, err := c.PatchV1PeopleIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1PeopleIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV1PeopleIdWithResponse(ctx context.Context, id int64, body PatchV1PeopleIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV1PeopleIdResponse, error) {
+	rsp, err := c.PatchV1PeopleId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1PeopleIdResponse(rsp)
+}
+
+// PostV1TokensAuthenticationWithBodyWithResponse request with arbitrary body returning *PostV1TokensAuthenticationResponse
+func (c *ClientWithResponses) PostV1TokensAuthenticationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TokensAuthenticationResponse, error) {
+	rsp, err := c.PostV1TokensAuthenticationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TokensAuthenticationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TokensAuthenticationWithResponse(ctx context.Context, body PostV1TokensAuthenticationJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TokensAuthenticationResponse, error) {
+	rsp, err := c.PostV1TokensAuthentication(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TokensAuthenticationResponse(rsp)
+}
+
+// PostV1UsersWithBodyWithResponse request with arbitrary body returning *PostV1UsersResponse
+func (c *ClientWithResponses) PostV1UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersResponse, error) {
+	rsp, err := c.PostV1UsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1UsersWithResponse(ctx context.Context, body PostV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersResponse, error) {
+	rsp, err := c.PostV1Users(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersResponse(rsp)
+}
+
+// PutV1UsersActivatedWithBodyWithResponse request with arbitrary body returning *PutV1UsersActivatedResponse
+func (c *ClientWithResponses) PutV1UsersActivatedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersActivatedResponse, error) {
+	rsp, err := c.PutV1UsersActivatedWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1UsersActivatedResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV1UsersActivatedWithResponse(ctx context.Context, body PutV1UsersActivatedJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV1UsersActivatedResponse, error) {
+	rsp, err := c.PutV1UsersActivated(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1UsersActivatedResponse(rsp)
+}
+
+// GetV1UsersIdPermissionsWithResponse request returning *GetV1UsersIdPermissionsResponse
+func (c *ClientWithResponses) GetV1UsersIdPermissionsWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetV1UsersIdPermissionsResponse, error) {
+	rsp, err := c.GetV1UsersIdPermissions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1UsersIdPermissionsResponse(rsp)
+}
+
+// PostV1UsersIdPermissionsWithBodyWithResponse request with arbitrary body returning *PostV1UsersIdPermissionsResponse
+func (c *ClientWithResponses) PostV1UsersIdPermissionsWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersIdPermissionsResponse, error) {
+	rsp, err := c.PostV1UsersIdPermissionsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersIdPermissionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1UsersIdPermissionsWithResponse(ctx context.Context, id int64, body PostV1UsersIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersIdPermissionsResponse, error) {
+	rsp, err := c.PostV1UsersIdPermissions(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersIdPermissionsResponse(rsp)
+}
+
+// DeleteV1UsersIdPermissionsCodeWithResponse request returning *DeleteV1UsersIdPermissionsCodeResponse
+func (c *ClientWithResponses) DeleteV1UsersIdPermissionsCodeWithResponse(ctx context.Context, id int64, code string, reqEditors ...RequestEditorFn) (*DeleteV1UsersIdPermissionsCodeResponse, error) {
+	rsp, err := c.DeleteV1UsersIdPermissionsCode(ctx, id, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1UsersIdPermissionsCodeResponse(rsp)
+}
+
+// ParseGetV1GenresResponse parses an HTTP response from a GetV1GenresWithResponse call
+func ParseGetV1GenresResponse(rsp *http.Response) (*GetV1GenresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GenresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Genres *[]Genre `json:"genres,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1GenresResponse parses an HTTP response from a PostV1GenresWithResponse call
+func ParsePostV1GenresResponse(rsp *http.Response) (*PostV1GenresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1GenresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Genre *Genre `json:"genre,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1GenresSlugResponse parses an HTTP response from a DeleteV1GenresSlugWithResponse call
+func ParseDeleteV1GenresSlugResponse(rsp *http.Response) (*DeleteV1GenresSlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1GenresSlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GenresSlugResponse parses an HTTP response from a GetV1GenresSlugWithResponse call
+func ParseGetV1GenresSlugResponse(rsp *http.Response) (*GetV1GenresSlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GenresSlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Genre *Genre `json:"genre,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV1GenresSlugResponse parses an HTTP response from a PatchV1GenresSlugWithResponse call
+func ParsePatchV1GenresSlugResponse(rsp *http.Response) (*PatchV1GenresSlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV1GenresSlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Genre *Genre `json:"genre,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesResponse parses an HTTP response from a GetV1MoviesWithResponse call
+func ParseGetV1MoviesResponse(rsp *http.Response) (*GetV1MoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Metadata Pagination details, empty when there are no results
+			Metadata *Metadata `json:"metadata,omitempty"`
+			Movies   *[]Movie  `json:"movies,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1MoviesResponse parses an HTTP response from a PostV1MoviesWithResponse call
+func ParsePostV1MoviesResponse(rsp *http.Response) (*PostV1MoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1MoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Movie *Movie `json:"movie,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesExportResponse parses an HTTP response from a GetV1MoviesExportWithResponse call
+func ParseGetV1MoviesExportResponse(rsp *http.Response) (*GetV1MoviesExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movies []Movie `json:"movies"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1MoviesImportResponse parses an HTTP response from a PostV1MoviesImportWithResponse call
+func ParsePostV1MoviesImportResponse(rsp *http.Response) (*PostV1MoviesImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1MoviesImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest struct {
+			Report ImportReport `json:"report"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Report ImportReport `json:"report"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesSearchResponse parses an HTTP response from a GetV1MoviesSearchWithResponse call
+func ParseGetV1MoviesSearchResponse(rsp *http.Response) (*GetV1MoviesSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Results *[]SearchResult `json:"results,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesTrashResponse parses an HTTP response from a GetV1MoviesTrashWithResponse call
+func ParseGetV1MoviesTrashResponse(rsp *http.Response) (*GetV1MoviesTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movies *[]Movie `json:"movies,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1MoviesIdResponse parses an HTTP response from a DeleteV1MoviesIdWithResponse call
+func ParseDeleteV1MoviesIdResponse(rsp *http.Response) (*DeleteV1MoviesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1MoviesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesIdResponse parses an HTTP response from a GetV1MoviesIdWithResponse call
+func ParseGetV1MoviesIdResponse(rsp *http.Response) (*GetV1MoviesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movie *Movie `json:"movie,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV1MoviesIdResponse parses an HTTP response from a PatchV1MoviesIdWithResponse call
+func ParsePatchV1MoviesIdResponse(rsp *http.Response) (*PatchV1MoviesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV1MoviesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movie *Movie `json:"movie,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesIdCreditsResponse parses an HTTP response from a GetV1MoviesIdCreditsWithResponse call
+func ParseGetV1MoviesIdCreditsResponse(rsp *http.Response) (*GetV1MoviesIdCreditsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesIdCreditsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Credits *[]Credit `json:"credits,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1MoviesIdCreditsResponse parses an HTTP response from a PostV1MoviesIdCreditsWithResponse call
+func ParsePostV1MoviesIdCreditsResponse(rsp *http.Response) (*PostV1MoviesIdCreditsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1MoviesIdCreditsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Credit *Credit `json:"credit,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1MoviesIdCreditsCreditIdResponse parses an HTTP response from a DeleteV1MoviesIdCreditsCreditIdWithResponse call
+func ParseDeleteV1MoviesIdCreditsCreditIdResponse(rsp *http.Response) (*DeleteV1MoviesIdCreditsCreditIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1MoviesIdCreditsCreditIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesIdHistoryResponse parses an HTTP response from a GetV1MoviesIdHistoryWithResponse call
+func ParseGetV1MoviesIdHistoryResponse(rsp *http.Response) (*GetV1MoviesIdHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesIdHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Revisions *[]MovieRevision `json:"revisions,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MoviesIdHistoryVersionResponse parses an HTTP response from a GetV1MoviesIdHistoryVersionWithResponse call
+func ParseGetV1MoviesIdHistoryVersionResponse(rsp *http.Response) (*GetV1MoviesIdHistoryVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MoviesIdHistoryVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Revision *MovieRevision `json:"revision,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1MoviesIdRestoreResponse parses an HTTP response from a PostV1MoviesIdRestoreWithResponse call
+func ParsePostV1MoviesIdRestoreResponse(rsp *http.Response) (*PostV1MoviesIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1MoviesIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movie *Movie `json:"movie,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1MoviesIdRevertVersionResponse parses an HTTP response from a PostV1MoviesIdRevertVersionWithResponse call
+func ParsePostV1MoviesIdRevertVersionResponse(rsp *http.Response) (*PostV1MoviesIdRevertVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1MoviesIdRevertVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Movie *Movie `json:"movie,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1PeopleResponse parses an HTTP response from a GetV1PeopleWithResponse call
+func ParseGetV1PeopleResponse(rsp *http.Response) (*GetV1PeopleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1PeopleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Metadata Pagination details, empty when there are no results
+			Metadata *Metadata `json:"metadata,omitempty"`
+			People   *[]Person `json:"people,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1PeopleResponse parses an HTTP response from a PostV1PeopleWithResponse call
+func ParsePostV1PeopleResponse(rsp *http.Response) (*PostV1PeopleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1PeopleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Person *Person `json:"person,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1PeopleIdResponse parses an HTTP response from a DeleteV1PeopleIdWithResponse call
+func ParseDeleteV1PeopleIdResponse(rsp *http.Response) (*DeleteV1PeopleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1PeopleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1PeopleIdResponse parses an HTTP response from a GetV1PeopleIdWithResponse call
+func ParseGetV1PeopleIdResponse(rsp *http.Response) (*GetV1PeopleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1PeopleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Person *Person `json:"person,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV1PeopleIdResponse parses an HTTP response from a PatchV1PeopleIdWithResponse call
+func ParsePatchV1PeopleIdResponse(rsp *http.Response) (*PatchV1PeopleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV1PeopleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Person *Person `json:"person,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1TokensAuthenticationResponse parses an HTTP response from a PostV1TokensAuthenticationWithResponse call
+func ParsePostV1TokensAuthenticationResponse(rsp *http.Response) (*PostV1TokensAuthenticationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1TokensAuthenticationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			AuthenticationToken *AuthenticationToken `json:"authentication_token,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1UsersResponse parses an HTTP response from a PostV1UsersWithResponse call
+func ParsePostV1UsersResponse(rsp *http.Response) (*PostV1UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1UsersActivatedResponse parses an HTTP response from a PutV1UsersActivatedWithResponse call
+func ParsePutV1UsersActivatedResponse(rsp *http.Response) (*PutV1UsersActivatedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1UsersActivatedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1UsersIdPermissionsResponse parses an HTTP response from a GetV1UsersIdPermissionsWithResponse call
+func ParseGetV1UsersIdPermissionsResponse(rsp *http.Response) (*GetV1UsersIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1UsersIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PermissionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1UsersIdPermissionsResponse parses an HTTP response from a PostV1UsersIdPermissionsWithResponse call
+func ParsePostV1UsersIdPermissionsResponse(rsp *http.Response) (*PostV1UsersIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1UsersIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PermissionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1UsersIdPermissionsCodeResponse parses an HTTP response from a DeleteV1UsersIdPermissionsCodeWithResponse call
+func ParseDeleteV1UsersIdPermissionsCodeResponse(rsp *http.Response) (*DeleteV1UsersIdPermissionsCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1UsersIdPermissionsCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PermissionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableEntity
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
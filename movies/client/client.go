@@ -0,0 +1,573 @@
+// Package client is a Go client for the movies API. The types and the
+// ClientWithResponses are generated from movies/api/movies.yaml, API wraps
+// them with retries, trace propagation, authentication and typed errors.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// propagator writes the W3C traceparent, tracestate and baggage headers, the
+// same ones the server reads.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// API is a client for the movies API. The generated operations of the
+// embedded ClientWithResponses remain available for anything the typed
+// methods don't cover, they get the same retries and request headers.
+type API struct {
+	*ClientWithResponses
+}
+
+type config struct {
+	httpClient  HttpRequestDoer
+	token       func(ctx context.Context) (string, error)
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+type Option func(*config)
+
+// WithDoer sets the client which sends the requests, such as an
+// *http.Client. The default is http.DefaultClient.
+func WithDoer(c HttpRequestDoer) Option {
+	return func(cfg *config) {
+		cfg.httpClient = c
+	}
+}
+
+// WithToken authenticates every request with the bearer token.
+func WithToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// WithTokenSource authenticates every request with the bearer token returned
+// by fn, requests are sent anonymously when it returns an empty token.
+func WithTokenSource(fn func(ctx context.Context) (string, error)) Option {
+	return func(cfg *config) {
+		cfg.token = fn
+	}
+}
+
+// WithRetries sets how many times a request is attempted when it fails
+// because of a transient problem and the delay the exponential backoff
+// starts at. The default is 3 attempts starting at 100ms, 1 disables
+// retries.
+func WithRetries(maxAttempts int, baseDelay time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxAttempts = max(maxAttempts, 1)
+		cfg.baseDelay = baseDelay
+	}
+}
+
+// New creates a client for the API at baseURL, such as
+// "https://greenlight.example.com".
+func New(baseURL string, opts ...Option) (*API, error) {
+	cfg := config{
+		httpClient:  http.DefaultClient,
+		maxAttempts: defaultMaxAttempts,
+		baseDelay:   defaultBaseDelay,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	doer := cfg.httpClient
+	if cfg.maxAttempts > 1 {
+		doer = &retryDoer{next: doer, maxAttempts: cfg.maxAttempts, baseDelay: cfg.baseDelay}
+	}
+
+	c, err := NewClientWithResponses(baseURL,
+		WithHTTPClient(doer),
+		WithRequestEditorFn(cfg.editRequest),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &API{ClientWithResponses: c}, nil
+}
+
+// editRequest propagates the trace of ctx and adds the bearer token.
+func (cfg *config) editRequest(ctx context.Context, req *http.Request) error {
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	if cfg.token == nil {
+		return nil
+	}
+
+	token, err := cfg.token(ctx)
+	if err != nil {
+		return fmt.Errorf("getting token: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return nil
+}
+
+// ListMovies returns a page of movies matching params, which may be nil.
+func (a *API) ListMovies(ctx context.Context, params *GetV1MoviesParams) ([]Movie, Metadata, error) {
+	if params == nil {
+		params = &GetV1MoviesParams{}
+	}
+
+	rsp, err := a.GetV1MoviesWithResponse(ctx, params)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	return deref(body.Movies), deref(body.Metadata), nil
+}
+
+// SearchMovies returns up to limit movies ranked by how well their title
+// matches q, a limit of 0 uses the server's default.
+func (a *API) SearchMovies(ctx context.Context, q string, limit int32) ([]SearchResult, error) {
+	params := &GetV1MoviesSearchParams{Q: q}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+
+	rsp, err := a.GetV1MoviesSearchWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(body.Results), nil
+}
+
+func (a *API) GetMovie(ctx context.Context, id int64) (Movie, error) {
+	rsp, err := a.GetV1MoviesIdWithResponse(ctx, id)
+	if err != nil {
+		return Movie{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Movie{}, err
+	}
+
+	return deref(body.Movie), nil
+}
+
+func (a *API) CreateMovie(ctx context.Context, in CreateMovieRequest) (Movie, error) {
+	rsp, err := a.PostV1MoviesWithResponse(ctx, in)
+	if err != nil {
+		return Movie{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusCreated, rsp.JSON201)
+	if err != nil {
+		return Movie{}, err
+	}
+
+	return deref(body.Movie), nil
+}
+
+// UpdateMovie changes the fields set in in. When version is not 0 the update
+// fails with an edit_conflict error if the movie was changed since that
+// version.
+func (a *API) UpdateMovie(ctx context.Context, id int64, version int32, in UpdateMovieRequest) (Movie, error) {
+	params := &PatchV1MoviesIdParams{}
+	if version != 0 {
+		params.XExpectedVersion = &version
+	}
+
+	rsp, err := a.PatchV1MoviesIdWithResponse(ctx, id, params, in)
+	if err != nil {
+		return Movie{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Movie{}, err
+	}
+
+	return deref(body.Movie), nil
+}
+
+// DeleteMovie moves the movie to the trash.
+func (a *API) DeleteMovie(ctx context.Context, id int64) error {
+	rsp, err := a.DeleteV1MoviesIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body, http.StatusOK)
+}
+
+// ListDeletedMovies returns the movies in the trash.
+func (a *API) ListDeletedMovies(ctx context.Context) ([]Movie, error) {
+	rsp, err := a.GetV1MoviesTrashWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(body.Movies), nil
+}
+
+// RestoreMovie moves the movie out of the trash.
+func (a *API) RestoreMovie(ctx context.Context, id int64) (Movie, error) {
+	rsp, err := a.PostV1MoviesIdRestoreWithResponse(ctx, id)
+	if err != nil {
+		return Movie{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Movie{}, err
+	}
+
+	return deref(body.Movie), nil
+}
+
+// MovieHistory returns the revisions of the movie, newest first.
+func (a *API) MovieHistory(ctx context.Context, id int64) ([]MovieRevision, error) {
+	rsp, err := a.GetV1MoviesIdHistoryWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(body.Revisions), nil
+}
+
+// GetMovieRevision returns the revision which produced the given version of
+// the movie.
+func (a *API) GetMovieRevision(ctx context.Context, id int64, version int32) (MovieRevision, error) {
+	rsp, err := a.GetV1MoviesIdHistoryVersionWithResponse(ctx, id, version)
+	if err != nil {
+		return MovieRevision{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return MovieRevision{}, err
+	}
+
+	return deref(body.Revision), nil
+}
+
+// RevertMovie applies the given version of the movie as a new version.
+func (a *API) RevertMovie(ctx context.Context, id int64, version int32) (Movie, error) {
+	rsp, err := a.PostV1MoviesIdRevertVersionWithResponse(ctx, id, version)
+	if err != nil {
+		return Movie{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Movie{}, err
+	}
+
+	return deref(body.Movie), nil
+}
+
+// ImportMovies streams a CSV or NDJSON body, as told by contentType, to the
+// import endpoint. When an all-or-nothing import is rejected the report is
+// returned together with a ProblemError for the 422.
+func (a *API) ImportMovies(
+	ctx context.Context, mode PostV1MoviesImportParamsMode, contentType string, body io.Reader,
+) (ImportReport, error) {
+	rsp, err := a.PostV1MoviesImportWithBodyWithResponse(ctx, &PostV1MoviesImportParams{Mode: &mode}, contentType, body)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	if rsp.JSON422 != nil {
+		return rsp.JSON422.Report, checkResponse(rsp.HTTPResponse, rsp.Body, http.StatusOK)
+	}
+	decoded, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	return decoded.Report, nil
+}
+
+// ExportMovies streams the movies matching params in the format of
+// mediaType, such as "text/csv". The caller must close the returned body, an
+// export which fails midway ends with an unexpected EOF.
+func (a *API) ExportMovies(ctx context.Context, params *GetV1MoviesExportParams, mediaType string) (io.ReadCloser, error) {
+	rsp, err := a.GetV1MoviesExport(ctx, params, func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Accept", mediaType)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		defer rsp.Body.Close()
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, decodeError(rsp, body)
+	}
+
+	return rsp.Body, nil
+}
+
+// ListGenres returns the genre catalogue, ordered by slug.
+func (a *API) ListGenres(ctx context.Context) ([]Genre, error) {
+	rsp, err := a.GetV1GenresWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(body.Genres), nil
+}
+
+func (a *API) GetGenre(ctx context.Context, slug string) (Genre, error) {
+	rsp, err := a.GetV1GenresSlugWithResponse(ctx, slug)
+	if err != nil {
+		return Genre{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Genre{}, err
+	}
+
+	return deref(body.Genre), nil
+}
+
+func (a *API) CreateGenre(ctx context.Context, in CreateGenreRequest) (Genre, error) {
+	rsp, err := a.PostV1GenresWithResponse(ctx, in)
+	if err != nil {
+		return Genre{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusCreated, rsp.JSON201)
+	if err != nil {
+		return Genre{}, err
+	}
+
+	return deref(body.Genre), nil
+}
+
+// UpdateGenre renames the genre or, when in.Aliases is set, replaces its
+// aliases.
+func (a *API) UpdateGenre(ctx context.Context, slug string, in UpdateGenreRequest) (Genre, error) {
+	rsp, err := a.PatchV1GenresSlugWithResponse(ctx, slug, in)
+	if err != nil {
+		return Genre{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Genre{}, err
+	}
+
+	return deref(body.Genre), nil
+}
+
+// DeleteGenre fails with a conflict error while movies have the genre.
+func (a *API) DeleteGenre(ctx context.Context, slug string) error {
+	rsp, err := a.DeleteV1GenresSlugWithResponse(ctx, slug)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body, http.StatusOK)
+}
+
+// ListPeople returns a page of people matching params, which may be nil,
+// ordered by name.
+func (a *API) ListPeople(ctx context.Context, params *GetV1PeopleParams) ([]Person, Metadata, error) {
+	if params == nil {
+		params = &GetV1PeopleParams{}
+	}
+
+	rsp, err := a.GetV1PeopleWithResponse(ctx, params)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	return deref(body.People), deref(body.Metadata), nil
+}
+
+func (a *API) GetPerson(ctx context.Context, id int64) (Person, error) {
+	rsp, err := a.GetV1PeopleIdWithResponse(ctx, id)
+	if err != nil {
+		return Person{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Person{}, err
+	}
+
+	return deref(body.Person), nil
+}
+
+func (a *API) CreatePerson(ctx context.Context, in CreatePersonRequest) (Person, error) {
+	rsp, err := a.PostV1PeopleWithResponse(ctx, in)
+	if err != nil {
+		return Person{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusCreated, rsp.JSON201)
+	if err != nil {
+		return Person{}, err
+	}
+
+	return deref(body.Person), nil
+}
+
+func (a *API) UpdatePerson(ctx context.Context, id int64, in UpdatePersonRequest) (Person, error) {
+	rsp, err := a.PatchV1PeopleIdWithResponse(ctx, id, in)
+	if err != nil {
+		return Person{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return Person{}, err
+	}
+
+	return deref(body.Person), nil
+}
+
+// DeletePerson fails with a conflict error while the person is credited on
+// movies.
+func (a *API) DeletePerson(ctx context.Context, id int64) error {
+	rsp, err := a.DeleteV1PeopleIdWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body, http.StatusOK)
+}
+
+// MovieCredits returns the cast and crew of the movie in billing order.
+func (a *API) MovieCredits(ctx context.Context, id int64) ([]Credit, error) {
+	rsp, err := a.GetV1MoviesIdCreditsWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(body.Credits), nil
+}
+
+// AddMovieCredit credits a person on the movie.
+func (a *API) AddMovieCredit(ctx context.Context, id int64, in CreateCreditRequest) (Credit, error) {
+	rsp, err := a.PostV1MoviesIdCreditsWithResponse(ctx, id, in)
+	if err != nil {
+		return Credit{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusCreated, rsp.JSON201)
+	if err != nil {
+		return Credit{}, err
+	}
+
+	return deref(body.Credit), nil
+}
+
+func (a *API) DeleteMovieCredit(ctx context.Context, id, creditID int64) error {
+	rsp, err := a.DeleteV1MoviesIdCreditsCreditIdWithResponse(ctx, id, creditID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body, http.StatusOK)
+}
+
+// CreateAuthenticationToken logs in, the token can be passed to WithToken.
+func (a *API) CreateAuthenticationToken(ctx context.Context, email, password string) (AuthenticationToken, error) {
+	rsp, err := a.PostV1TokensAuthenticationWithResponse(ctx, CreateAuthenticationTokenRequest{
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return AuthenticationToken{}, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusCreated, rsp.JSON201)
+	if err != nil {
+		return AuthenticationToken{}, err
+	}
+
+	return deref(body.AuthenticationToken), nil
+}
+
+// GetPermissions returns the codes of the permissions granted to the user.
+func (a *API) GetPermissions(ctx context.Context, userID int64) ([]string, error) {
+	rsp, err := a.GetV1UsersIdPermissionsWithResponse(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Permissions, nil
+}
+
+// GrantPermission grants the permission to the user and returns all of the
+// user's permissions.
+func (a *API) GrantPermission(ctx context.Context, userID int64, code GrantPermissionRequestCode) ([]string, error) {
+	rsp, err := a.PostV1UsersIdPermissionsWithResponse(ctx, userID, GrantPermissionRequest{Code: code})
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Permissions, nil
+}
+
+// RevokePermission takes the permission away from the user and returns the
+// user's remaining permissions.
+func (a *API) RevokePermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	rsp, err := a.DeleteV1UsersIdPermissionsCodeWithResponse(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	body, err := result(rsp.HTTPResponse, rsp.Body, http.StatusOK, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Permissions, nil
+}
+
+// result returns the decoded body of a response with the wanted status.
+func result[T any](rsp *http.Response, body []byte, want int, decoded *T) (*T, error) {
+	if err := checkResponse(rsp, body, want); err != nil {
+		return nil, err
+	}
+	if decoded == nil {
+		return nil, fmt.Errorf("movies api: unexpected %s response", rsp.Header.Get("Content-Type"))
+	}
+	return decoded, nil
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,532 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zbsss/greenlight/movies/backend/api/apitest"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/mocks"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTestServer serves the API like main does, with middleware wrapping the
+// whole server, and returns a user with the movies:write permission.
+func newTestServer(t *testing.T, middleware func(http.Handler) http.Handler) (ts *testserver.Server, email string) {
+	t.Helper()
+
+	env := apitest.New(t)
+	env.DB.Reset(mocks.TestMovie1)
+
+	email = "writer@example.com"
+	env.CreateUser(t, email, true, service.PermissionMoviesWrite)
+
+	handler := env.Handler
+	if middleware != nil {
+		handler = middleware(handler)
+	}
+
+	ts = testserver.New(handler)
+	t.Cleanup(ts.Close)
+
+	return ts, email
+}
+
+func newTestAPI(t *testing.T, ts *testserver.Server, opts ...Option) *API {
+	t.Helper()
+
+	c, err := New(ts.URL, append([]Option{WithDoer(ts.Client()), WithRetries(3, time.Millisecond)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	anonymous := newTestAPI(t, ts)
+
+	_, err := anonymous.GetMovie(ctx, 1)
+	if !HasCode(err, ProblemCodeAuthenticationRequired) {
+		t.Fatalf("expected authentication_required; got %v", err)
+	}
+
+	_, err = anonymous.CreateAuthenticationToken(ctx, email, "wrong password")
+	if !HasCode(err, ProblemCodeInvalidCredentials) {
+		t.Fatalf("expected invalid_credentials; got %v", err)
+	}
+
+	token, err := anonymous.CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	movie, err := c.CreateMovie(ctx, CreateMovieRequest{Title: "Moana", Year: 2016, RuntimeMin: 107, Genres: []string{"animation"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movie.Title != "Moana" || movie.Runtime != "107 min" {
+		t.Fatalf("unexpected movie %+v", movie)
+	}
+
+	got, err := c.GetMovie(ctx, movie.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Id != movie.Id || got.Version != movie.Version {
+		t.Fatalf("expected %+v; got %+v", movie, got)
+	}
+
+	title := "Moana 2"
+	updated, err := c.UpdateMovie(ctx, movie.Id, movie.Version, UpdateMovieRequest{Title: &title})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Title != title || updated.Version != movie.Version+1 {
+		t.Fatalf("unexpected update %+v", updated)
+	}
+
+	_, err = c.UpdateMovie(ctx, movie.Id, movie.Version, UpdateMovieRequest{Title: &title})
+	if !HasCode(err, ProblemCodeEditConflict) {
+		t.Fatalf("expected edit_conflict for a stale version; got %v", err)
+	}
+
+	movies, metadata, err := c.ListMovies(ctx, &GetV1MoviesParams{Title: &title})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].Id != movie.Id || metadata.TotalRecords == nil || *metadata.TotalRecords != 1 {
+		t.Fatalf("unexpected listing %+v %+v", movies, metadata)
+	}
+
+	if err := c.DeleteMovie(ctx, movie.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetMovie(ctx, movie.Id); !IsNotFound(err) {
+		t.Fatalf("expected not_found for a deleted movie; got %v", err)
+	}
+
+	deleted, err := c.ListDeletedMovies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || deleted[0].Id != movie.Id {
+		t.Fatalf("unexpected trash %+v", deleted)
+	}
+
+	restored, err := c.RestoreMovie(ctx, movie.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Id != movie.Id || restored.DeletedAt != nil {
+		t.Fatalf("unexpected restored movie %+v", restored)
+	}
+}
+
+func TestMovieHistory(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	movie, err := c.CreateMovie(ctx, CreateMovieRequest{Title: "Moana", Year: 2016, RuntimeMin: 107, Genres: []string{"animation"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	title := "Moana 2"
+	if _, err := c.UpdateMovie(ctx, movie.Id, movie.Version, UpdateMovieRequest{Title: &title}); err != nil {
+		t.Fatal(err)
+	}
+
+	reverted, err := c.RevertMovie(ctx, movie.Id, movie.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverted.Title != movie.Title || reverted.Version != movie.Version+2 {
+		t.Fatalf("unexpected reverted movie %+v", reverted)
+	}
+
+	revisions, err := c.MovieHistory(ctx, movie.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 3 || revisions[0].Operation != Revert || revisions[2].Operation != Create {
+		t.Fatalf("unexpected history %+v", revisions)
+	}
+	if revisions[1].UserId == nil || revisions[1].TraceId == "" {
+		t.Errorf("expected the update to be attributed to the user and the request; got %+v", revisions[1])
+	}
+
+	revision, err := c.GetMovieRevision(ctx, movie.Id, movie.Version+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Movie.Title != title || len(revision.ChangedFields) != 1 || revision.ChangedFields[0] != "title" {
+		t.Fatalf("unexpected revision %+v", revision)
+	}
+
+	if _, err := c.GetMovieRevision(ctx, movie.Id, 10); !IsNotFound(err) {
+		t.Fatalf("expected not_found for a missing version; got %v", err)
+	}
+}
+
+func TestGenres(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	aliases := []string{"Noir"}
+	genre, err := c.CreateGenre(ctx, CreateGenreRequest{Slug: "film-noir", Name: "Film Noir", Aliases: &aliases})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genre.Slug != "film-noir" || len(genre.Aliases) != 1 || genre.Aliases[0] != "noir" {
+		t.Fatalf("unexpected genre %+v", genre)
+	}
+
+	movie, err := c.CreateMovie(ctx, CreateMovieRequest{Title: "The Third Man", Year: 1949, RuntimeMin: 104, Genres: []string{"noir"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movie.Genres) != 1 || movie.Genres[0] != "film-noir" {
+		t.Fatalf("expected the alias to be stored as the slug; got %+v", movie.Genres)
+	}
+
+	_, err = c.CreateMovie(ctx, CreateMovieRequest{Title: "Chinatown", Year: 1974, RuntimeMin: 130, Genres: []string{"film-nior"}})
+	if !HasCode(err, ProblemCodeValidationFailed) {
+		t.Fatalf("expected validation_failed for an unknown genre; got %v", err)
+	}
+
+	name := "Noir"
+	if _, err := c.UpdateGenre(ctx, "film-noir", UpdateGenreRequest{Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetGenre(ctx, "film-noir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != name {
+		t.Fatalf("expected the genre to be renamed; got %+v", got)
+	}
+
+	if err := c.DeleteGenre(ctx, "film-noir"); !HasCode(err, ProblemCodeConflict) {
+		t.Fatalf("expected conflict for a genre in use; got %v", err)
+	}
+
+	genres, err := c.ListGenres(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(genres) == 0 || genres[0].Slug != "action" {
+		t.Fatalf("expected the catalogue ordered by slug; got %+v", genres)
+	}
+}
+
+func TestPeopleAndCredits(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	birthYear := int32(1937)
+	person, err := c.CreatePerson(ctx, CreatePersonRequest{Name: "Ridley Scott", BirthYear: &birthYear})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	movie, err := c.CreateMovie(ctx, CreateMovieRequest{Title: "Alien", Year: 1979, RuntimeMin: 117, Genres: []string{"sci-fi"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credit, err := c.AddMovieCredit(ctx, movie.Id, CreateCreditRequest{PersonId: person.Id, Role: Director})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credit.PersonName != "Ridley Scott" || credit.BillingOrder != 1 {
+		t.Fatalf("unexpected credit %+v", credit)
+	}
+
+	_, err = c.AddMovieCredit(ctx, movie.Id, CreateCreditRequest{PersonId: person.Id, Role: Director})
+	if !HasCode(err, ProblemCodeValidationFailed) {
+		t.Fatalf("expected validation_failed for a duplicate credit; got %v", err)
+	}
+
+	credits, err := c.MovieCredits(ctx, movie.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(credits) != 1 || credits[0].Id != credit.Id {
+		t.Fatalf("expected the credit; got %+v", credits)
+	}
+
+	movies, _, err := c.ListMovies(ctx, &GetV1MoviesParams{PersonId: &person.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movies) != 1 || movies[0].Id != movie.Id {
+		t.Fatalf("expected the movies of the person; got %+v", movies)
+	}
+
+	if err := c.DeletePerson(ctx, person.Id); !HasCode(err, ProblemCodeConflict) {
+		t.Fatalf("expected conflict for a credited person; got %v", err)
+	}
+	if err := c.DeleteMovieCredit(ctx, movie.Id, credit.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeletePerson(ctx, person.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetPerson(ctx, person.Id); !HasCode(err, ProblemCodeNotFound) {
+		t.Fatalf("expected not_found for a deleted person; got %v", err)
+	}
+}
+
+func TestImportMovies(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	const body = "title,year,runtime,genres\nMoana,2016,107,animation\nUp,2009,,animation\n"
+
+	report, err := c.ImportMovies(ctx, PostV1MoviesImportParamsModeAllOrNothing, "text/csv", strings.NewReader(body))
+	if !HasCode(err, ProblemCodeValidationFailed) {
+		t.Fatalf("expected validation_failed for a rejected import; got %v", err)
+	}
+	if report.Rejected != 1 || report.Imported != 0 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	report, err = c.ImportMovies(ctx, PostV1MoviesImportParamsModeBestEffort, "text/csv", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Imported != 1 || report.Rejected != 1 || report.Rows[1].Status != Rejected {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
+
+func TestExportMovies(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	const csv = "title,year,runtime,genres\nMoana,2016,107,animation\nUp,2009,96,animation\n"
+	if _, err := c.ImportMovies(ctx, PostV1MoviesImportParamsModeAllOrNothing, "text/csv", strings.NewReader(csv)); err != nil {
+		t.Fatal(err)
+	}
+
+	sort := GetV1MoviesExportParamsSortYear
+	body, err := c.ExportMovies(ctx, &GetV1MoviesExportParams{Sort: &sort}, "text/csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	export, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(export), "id,title,year,runtime,genres,version\n") || !strings.Contains(string(export), ",Up,2009,96,animation,1\n") {
+		t.Fatalf("unexpected export %q", export)
+	}
+
+	_, err = c.ExportMovies(ctx, nil, "text/html")
+	if !HasCode(err, ProblemCodeNotAcceptable) {
+		t.Fatalf("expected not_acceptable; got %v", err)
+	}
+}
+
+func TestProblemError(t *testing.T) {
+	ctx := context.Background()
+	ts, email := newTestServer(t, nil)
+
+	token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestAPI(t, ts, WithToken(token.Token))
+
+	_, err = c.CreateMovie(ctx, CreateMovieRequest{Title: "", Year: 2016, RuntimeMin: 107, Genres: []string{"animation"}})
+
+	problem, ok := err.(*ProblemError)
+	if !ok {
+		t.Fatalf("expected *ProblemError; got %T: %v", err, err)
+	}
+	if problem.StatusCode != http.StatusUnprocessableEntity || problem.Code != ProblemCodeValidationFailed {
+		t.Fatalf("unexpected problem %+v", problem.Problem)
+	}
+	if _, ok := problem.FieldErrors()["title"]; !ok {
+		t.Errorf("expected a field error for title; got %v", problem.FieldErrors())
+	}
+	if problem.Instance == nil || *problem.Instance == "" {
+		t.Error("expected the trace ID in the instance")
+	}
+}
+
+func TestTracePropagation(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	ts, _ := newTestServer(t, nil)
+
+	_, err := newTestAPI(t, ts).GetMovie(ctx, 1)
+
+	problem, ok := err.(*ProblemError)
+	if !ok {
+		t.Fatalf("expected *ProblemError; got %T: %v", err, err)
+	}
+	if problem.Instance == nil || *problem.Instance != traceID.String() {
+		t.Errorf("expected the server to continue trace %s; got instance %v", traceID, problem.Instance)
+	}
+}
+
+// flaky fails the first requests with the status, up to failures of them,
+// and records the bodies of all requests.
+type flaky struct {
+	mu       sync.Mutex
+	status   int
+	failures int
+	requests int
+	bodies   []string
+}
+
+func (f *flaky) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests++
+		body, _ := io.ReadAll(r.Body)
+		f.bodies = append(f.bodies, string(body))
+		fail := f.requests <= f.failures
+		f.mu.Unlock()
+
+		if fail {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, http.StatusText(f.status), f.status)
+			return
+		}
+
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		status       int
+		failures     int
+		post         bool
+		wantRequests int
+		wantCode     ProblemCode
+	}{
+		{
+			name:         "get retried on 503",
+			status:       http.StatusServiceUnavailable,
+			failures:     2,
+			wantRequests: 3,
+		},
+		{
+			name:         "get gives up after max attempts",
+			status:       http.StatusBadGateway,
+			failures:     5,
+			wantRequests: 3,
+			wantCode:     ProblemCodeInternalError,
+		},
+		{
+			name:         "post not retried on 503",
+			status:       http.StatusServiceUnavailable,
+			failures:     1,
+			post:         true,
+			wantRequests: 1,
+			wantCode:     ProblemCodeInternalError,
+		},
+		{
+			name:         "post retried on 429",
+			status:       http.StatusTooManyRequests,
+			failures:     1,
+			post:         true,
+			wantRequests: 2,
+			// The credentials are wrong, which shows that the body was
+			// resent.
+			wantCode: ProblemCodeInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &flaky{status: tt.status}
+			ts, email := newTestServer(t, f.middleware)
+
+			token, err := newTestAPI(t, ts).CreateAuthenticationToken(ctx, email, "pa55word")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := newTestAPI(t, ts, WithToken(token.Token))
+
+			f.requests, f.bodies, f.failures = 0, nil, tt.failures
+
+			if tt.post {
+				_, err = c.CreateAuthenticationToken(ctx, email, "wrong password")
+			} else {
+				_, _, err = c.ListMovies(ctx, nil)
+			}
+
+			switch {
+			case tt.wantCode == "" && err != nil:
+				t.Fatalf("unexpected error %v", err)
+			case tt.wantCode != "" && !HasCode(err, tt.wantCode):
+				t.Fatalf("expected %s; got %v", tt.wantCode, err)
+			}
+
+			if f.requests != tt.wantRequests {
+				t.Errorf("expected %d requests; got %d", tt.wantRequests, f.requests)
+			}
+			for i, body := range f.bodies {
+				if body != f.bodies[0] {
+					t.Errorf("expected request %d to resend %q; got %q", i+1, f.bodies[0], body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+)
+
+// ProblemError is returned when the API responds with an unexpected status. It
+// holds the problem details the server sent, or a problem made up from the
+// status when the body was not a problem, such as the response of a proxy.
+type ProblemError struct {
+	StatusCode int
+	Problem
+}
+
+func (e *ProblemError) Error() string {
+	if e.Detail != nil && *e.Detail != "" {
+		return fmt.Sprintf("movies api: %d %s: %s", e.StatusCode, e.Code, *e.Detail)
+	}
+	return fmt.Sprintf("movies api: %d %s", e.StatusCode, e.Code)
+}
+
+// FieldErrors returns the invalid fields of a validation_failed problem.
+func (e *ProblemError) FieldErrors() map[string]string {
+	if e.Errors == nil {
+		return nil
+	}
+	return *e.Errors
+}
+
+// HasCode reports whether err is a *ProblemError with the given problem code.
+func HasCode(err error, code ProblemCode) bool {
+	var apiErr *ProblemError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// IsNotFound reports whether err is a *ProblemError for a missing resource.
+func IsNotFound(err error) bool {
+	return HasCode(err, ProblemCodeNotFound)
+}
+
+// checkResponse returns a *ProblemError unless rsp has the wanted status.
+func checkResponse(rsp *http.Response, body []byte, want int) error {
+	if rsp.StatusCode == want {
+		return nil
+	}
+	return decodeError(rsp, body)
+}
+
+func decodeError(rsp *http.Response, body []byte) *ProblemError {
+	apiErr := &ProblemError{StatusCode: rsp.StatusCode}
+
+	mediaType, _, _ := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if mediaType == "application/problem+json" && json.Unmarshal(body, &apiErr.Problem) == nil && apiErr.Code != "" {
+		return apiErr
+	}
+
+	apiErr.Problem = Problem{
+		Code:   codeForStatus(rsp.StatusCode),
+		Status: rsp.StatusCode,
+		Title:  http.StatusText(rsp.StatusCode),
+		Type:   "about:blank",
+	}
+	return apiErr
+}
+
+// codeForStatus guesses the problem code of responses which didn't come
+// with one.
+func codeForStatus(status int) ProblemCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ProblemCodeBadRequest
+	case http.StatusUnauthorized:
+		return ProblemCodeAuthenticationRequired
+	case http.StatusForbidden:
+		return ProblemCodeForbidden
+	case http.StatusNotFound:
+		return ProblemCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ProblemCodeMethodNotAllowed
+	case http.StatusConflict:
+		return ProblemCodeEditConflict
+	case http.StatusNotAcceptable:
+		return ProblemCodeNotAcceptable
+	case http.StatusUnsupportedMediaType:
+		return ProblemCodeUnsupportedMediaType
+	case http.StatusUnprocessableEntity:
+		return ProblemCodeValidationFailed
+	case http.StatusTooManyRequests:
+		return ProblemCodeRateLimitExceeded
+	}
+	return ProblemCodeInternalError
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 100 * time.Millisecond
+	maxRetryDelay      = 10 * time.Second
+)
+
+// retryDoer retries requests which failed because of a transient problem:
+// network errors and 502, 503 and 504 responses for idempotent requests, and
+// 429 responses for any request since the server didn't process it.
+type retryDoer struct {
+	next        HttpRequestDoer
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		rsp, err := d.next.Do(req)
+
+		if attempt >= d.maxAttempts || ctx.Err() != nil || !d.retryable(req, rsp, err) {
+			return rsp, err
+		}
+
+		// Requests with a body can only be resent when it can be read again.
+		retry := req
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return rsp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return rsp, err
+			}
+			retry = req.Clone(ctx)
+			retry.Body = body
+		}
+
+		delay := d.delay(attempt, rsp)
+		if rsp != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 4096))
+			_ = rsp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = retry
+	}
+}
+
+func (d *retryDoer) retryable(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// delay honors the Retry-After header of the response and otherwise backs
+// off exponentially with full jitter.
+func (d *retryDoer) delay(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if seconds, err := strconv.Atoi(rsp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, maxRetryDelay)
+		}
+	}
+
+	backoff := min(d.baseDelay<<(attempt-1), maxRetryDelay)
+	if backoff <= 0 {
+		return 0
+	}
+	return rand.N(backoff)
+}
+
+// isIdempotent reports whether sending a request with the method more than
+// once has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
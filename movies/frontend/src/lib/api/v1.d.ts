@@ -11,31 +11,1359 @@ export interface paths {
             path?: never;
             cookie?: never;
         };
-        /** List all movies */
+        /** List movies */
         get: {
+            parameters: {
+                query?: {
+                    /** @description Only return movies whose title contains this value (case-insensitive) */
+                    title?: string;
+                    /** @description Only return movies which have all of these genres, given by slug or alias */
+                    genres?: string[];
+                    year_min?: number;
+                    year_max?: number;
+                    /** @description Minimum runtime in minutes */
+                    runtime_min?: number;
+                    /** @description Maximum runtime in minutes */
+                    runtime_max?: number;
+                    /** @description Only return movies on which this person is credited */
+                    person_id?: number;
+                    /** @description Sort order, prefix with "-" for descending */
+                    sort?: "id" | "title" | "year" | "runtime" | "-id" | "-title" | "-year" | "-runtime";
+                    page?: number;
+                    page_size?: number;
+                    /** @description Pagination mode. Keyset pagination ignores page numbers and instead
+                     *     returns a next_cursor which resumes the listing after the last movie. */
+                    pagination?: "offset" | "keyset";
+                    /** @description Opaque next_cursor from a previous keyset page, implies keyset pagination */
+                    cursor?: string;
+                };
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description List of movies */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movies?: components["schemas"]["Movie"][];
+                            metadata?: components["schemas"]["Metadata"];
+                        };
+                    };
+                };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        /** Create a new movie */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["CreateMovieRequest"];
+                };
+            };
+            responses: {
+                /** @description Movie created successfully */
+                201: {
+                    headers: {
+                        /** @description URL of the newly created movie */
+                        Location?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movie?: components["schemas"]["Movie"];
+                        };
+                    };
+                };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get a movie by ID */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Movie found */
+                200: {
+                    headers: {
+                        /** @description Version of the movie, usable in If-Match when updating it */
+                        ETag?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movie?: components["schemas"]["Movie"];
+                        };
+                    };
+                };
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        /** Move a movie to the trash */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Movie moved to the trash */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            message?: string;
+                        };
+                    };
+                };
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        options?: never;
+        head?: never;
+        /**
+         * Update a movie
+         * @description The update is only applied if the movie has not been changed since it
+         *     was read. The expected version can be passed explicitly with If-Match
+         *     (using the ETag returned by GET) or X-Expected-Version.
+         */
+        patch: {
+            parameters: {
+                query?: never;
+                header?: {
+                    /** @description ETag of the version the update is based on */
+                    "If-Match"?: string;
+                    /** @description Version the update is based on */
+                    "X-Expected-Version"?: number;
+                };
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["UpdateMovieRequest"];
+                };
+            };
+            responses: {
+                /** @description Movie updated successfully */
+                200: {
+                    headers: {
+                        /** @description Version of the updated movie */
+                        ETag?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movie?: components["schemas"]["Movie"];
+                        };
+                    };
+                };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                /** @description The movie was changed since the expected version */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        trace?: never;
+    };
+    "/v1/movies/search": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Search movies by title
+         * @description Full-text search over movie titles which also matches partial words
+         *     and misspellings. Results are ordered by relevance.
+         */
+        get: {
+            parameters: {
+                query: {
+                    q: string;
+                    limit?: number;
+                };
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Matching movies, most relevant first */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            results?: components["schemas"]["SearchResult"][];
+                        };
+                    };
+                };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/trash": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List movies in the trash */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description List of deleted movies */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movies?: components["schemas"]["Movie"][];
+                        };
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/export": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Export movies as CSV, NDJSON or JSON
+         * @description Streams every movie matching the filters, in the format chosen by the
+         *     Accept header (JSON when it is missing). Movies are sent as they are
+         *     read from the database, so the export is not limited by the page
+         *     size. A response which ends without its last row was cut short by an
+         *     error after the export started.
+         */
+        get: {
+            parameters: {
+                query?: {
+                    /** @description Only export movies whose title contains this value (case-insensitive) */
+                    title?: string;
+                    /** @description Only export movies which have all of these genres, given by slug or alias */
+                    genres?: string[];
+                    year_min?: number;
+                    year_max?: number;
+                    /** @description Minimum runtime in minutes */
+                    runtime_min?: number;
+                    /** @description Maximum runtime in minutes */
+                    runtime_max?: number;
+                    /** @description Only export movies on which this person is credited */
+                    person_id?: number;
+                    /** @description Sort order, prefix with "-" for descending */
+                    sort?: "id" | "title" | "year" | "runtime" | "-id" | "-title" | "-year" | "-runtime";
+                };
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The movies. CSV has an id,title,year,runtime,genres,version header
+                 *     with the runtime in minutes and the genres comma separated, it can
+                 *     be imported again. NDJSON has one Movie per line. */
+                200: {
+                    headers: {
+                        /** @description Suggested file name of the download */
+                        "Content-Disposition"?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movies: components["schemas"]["Movie"][];
+                        };
+                        "text/csv": string;
+                        "application/x-ndjson": string;
+                    };
+                };
+                /** @description None of the media types in the Accept header can be produced */
+                406: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/import": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Import movies from CSV or NDJSON
+         * @description Streams movies from a CSV file, with a header naming the title, year,
+         *     runtime and genres columns (genres comma separated), or from
+         *     newline-delimited CreateMovieRequest objects. Every row is validated
+         *     like a created movie and the valid rows are inserted in batches in a
+         *     single transaction. In all_or_nothing mode nothing is imported when
+         *     any row is rejected, in best_effort mode the valid rows are imported
+         *     and the rejected ones skipped.
+         */
+        post: {
+            parameters: {
+                query?: {
+                    mode?: "all_or_nothing" | "best_effort";
+                };
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "text/csv": string;
+                    "application/x-ndjson": string;
+                };
+            };
+            responses: {
+                /** @description Movies imported, rows rejected in best_effort mode are listed in the report */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            report: components["schemas"]["ImportReport"];
+                        };
+                    };
+                };
+                /** @description The body could not be read, such as a CSV without the required columns */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                /** @description Some rows were rejected in all_or_nothing mode and nothing was imported */
+                422: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            report: components["schemas"]["ImportReport"];
+                        };
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/restore": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Restore a movie from the trash */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Movie restored successfully */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movie?: components["schemas"]["Movie"];
+                        };
+                    };
+                };
+                /** @description Movie not found in the trash */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/history": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List the revisions of a movie
+         * @description Every change to a movie is recorded as a revision holding the state
+         *     of the movie after the change, newest first. The history of deleted
+         *     movies can still be read.
+         */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Revisions of the movie */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            revisions?: components["schemas"]["MovieRevision"][];
+                        };
+                    };
+                };
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/history/{version}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the revision which produced a version of a movie */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                    version: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The revision */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            revision?: components["schemas"]["MovieRevision"];
+                        };
+                    };
+                };
+                /** @description Movie or version not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/revert/{version}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Revert a movie to a previous version
+         * @description Applies the snapshot of the version to the movie as a new version,
+         *     the history is kept. Deleted movies have to be restored first.
+         */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                    version: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Movie reverted successfully */
+                200: {
+                    headers: {
+                        /** @description Version of the reverted movie */
+                        ETag?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            movie?: components["schemas"]["Movie"];
+                        };
+                    };
+                };
+                /** @description Movie or version not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                /** @description The movie was changed while it was being reverted */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/credits": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the cast and crew of a movie */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Credits of the movie in billing order */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            credits?: components["schemas"]["Credit"][];
+                        };
+                    };
+                };
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        /**
+         * Credit a person on a movie
+         * @description Credits are not part of the history of the movie and do not change its version.
+         */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["CreateCreditRequest"];
+                };
+            };
+            responses: {
+                /** @description Credit created successfully */
+                201: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            credit?: components["schemas"]["Credit"];
+                        };
+                    };
+                };
+                /** @description Movie not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/movies/{id}/credits/{creditId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Remove a credit from a movie */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                    creditId: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Credit deleted successfully */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            message?: string;
+                        };
+                    };
+                };
+                /** @description Movie or credit not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/genres": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the genre catalogue */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Genres ordered by slug */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            genres?: components["schemas"]["Genre"][];
+                        };
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        /** Add a genre to the catalogue */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["CreateGenreRequest"];
+                };
+            };
+            responses: {
+                /** @description Genre created successfully */
+                201: {
+                    headers: {
+                        /** @description URL of the created genre */
+                        Location?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            genre?: components["schemas"]["Genre"];
+                        };
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/genres/{slug}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get a genre by slug */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    slug: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The genre */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            genre?: components["schemas"]["Genre"];
+                        };
+                    };
+                };
+                /** @description Genre not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        /**
+         * Delete a genre and its aliases
+         * @description Genres which movies have, including the movies in the trash, can not be deleted.
+         */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    slug: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Genre deleted successfully */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            message?: string;
+                        };
+                    };
+                };
+                /** @description Genre not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                /** @description Movies have the genre */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        options?: never;
+        head?: never;
+        /** Rename a genre or replace its aliases */
+        patch: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    slug: string;
+                };
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["UpdateGenreRequest"];
+                };
+            };
+            responses: {
+                /** @description Genre updated successfully */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            genre?: components["schemas"]["Genre"];
+                        };
+                    };
+                };
+                /** @description Genre not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        trace?: never;
+    };
+    "/v1/people": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List people */
+        get: {
+            parameters: {
+                query?: {
+                    /** @description Only return people whose name contains this value (case-insensitive) */
+                    name?: string;
+                    page?: number;
+                    page_size?: number;
+                };
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description People ordered by name */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            people?: components["schemas"]["Person"][];
+                            metadata?: components["schemas"]["Metadata"];
+                        };
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        /** Add a person */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["CreatePersonRequest"];
+                };
+            };
+            responses: {
+                /** @description Person created successfully */
+                201: {
+                    headers: {
+                        /** @description URL of the created person */
+                        Location?: string;
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            person?: components["schemas"]["Person"];
+                        };
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/people/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get a person by ID */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The person */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            person?: components["schemas"]["Person"];
+                        };
+                    };
+                };
+                /** @description Person not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        put?: never;
+        post?: never;
+        /**
+         * Delete a person
+         * @description People who are credited on movies, including the movies in the trash, can not be deleted.
+         */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Person deleted successfully */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            message?: string;
+                        };
+                    };
+                };
+                /** @description Person not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                /** @description The person is credited on movies */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        options?: never;
+        head?: never;
+        /** Update a person */
+        patch: {
             parameters: {
                 query?: never;
                 header?: never;
-                path?: never;
+                path: {
+                    id: number;
+                };
                 cookie?: never;
             };
-            requestBody?: never;
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["UpdatePersonRequest"];
+                };
+            };
             responses: {
-                /** @description List of movies */
+                /** @description Person updated successfully */
                 200: {
                     headers: {
                         [name: string]: unknown;
                     };
                     content: {
                         "application/json": {
-                            movies?: components["schemas"]["Movie"][];
+                            person?: components["schemas"]["Person"];
                         };
                     };
                 };
+                /** @description Person not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
             };
         };
+        trace?: never;
+    };
+    "/v1/users": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
         put?: never;
-        /** Create a new movie */
+        /**
+         * Register a new user
+         * @description Creates an inactive user and sends them an activation token
+         */
         post: {
             parameters: {
                 query?: never;
@@ -45,50 +1373,180 @@ export interface paths {
             };
             requestBody: {
                 content: {
-                    "application/json": components["schemas"]["CreateMovieRequest"];
+                    "application/json": components["schemas"]["RegisterUserRequest"];
                 };
             };
             responses: {
-                /** @description Movie created successfully */
-                201: {
+                /** @description User registered, activation token sent */
+                202: {
                     headers: {
-                        /** @description URL of the newly created movie */
-                        Location?: string;
                         [name: string]: unknown;
                     };
                     content: {
                         "application/json": {
-                            movie?: components["schemas"]["Movie"];
+                            user?: components["schemas"]["User"];
                         };
                     };
                 };
                 /** @description Bad request */
                 400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/users/activated": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /** Activate a user */
+        put: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["ActivateUserRequest"];
+                };
+            };
+            responses: {
+                /** @description User activated successfully */
+                200: {
                     headers: {
                         [name: string]: unknown;
                     };
                     content: {
                         "application/json": {
-                            error?: string;
+                            user?: components["schemas"]["User"];
                         };
                     };
                 };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                /** @description Edit conflict */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Error"];
             };
         };
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/movies/{id}": {
+    "/v1/tokens/authentication": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Get a movie by ID */
+        get?: never;
+        put?: never;
+        /**
+         * Create an authentication token
+         * @description Exchanges a user's credentials for a bearer token
+         */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["CreateAuthenticationTokenRequest"];
+                };
+            };
+            responses: {
+                /** @description Authentication token created */
+                201: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            authentication_token?: components["schemas"]["AuthenticationToken"];
+                        };
+                    };
+                };
+                /** @description Bad request */
+                400: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                /** @description Invalid credentials */
+                401: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/users/{id}/permissions": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        /**
+         * List the permissions of a user
+         * @description Requires the permissions:admin permission
+         */
         get: {
             parameters: {
                 query?: never;
@@ -100,33 +1558,35 @@ export interface paths {
             };
             requestBody?: never;
             responses: {
-                /** @description Movie found */
+                /** @description Permissions granted to the user */
                 200: {
                     headers: {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": {
-                            movie?: components["schemas"]["Movie"];
-                        };
+                        "application/json": components["schemas"]["PermissionsResponse"];
                     };
                 };
-                /** @description Movie not found */
+                /** @description User not found */
                 404: {
                     headers: {
                         [name: string]: unknown;
                     };
-                    content?: never;
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
                 };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
             };
         };
         put?: never;
-        post?: never;
-        delete?: never;
-        options?: never;
-        head?: never;
-        /** Update a movie */
-        patch: {
+        /**
+         * Grant a permission to a user
+         * @description Requires the permissions:admin permission
+         */
+        post: {
             parameters: {
                 query?: never;
                 header?: never;
@@ -137,19 +1597,17 @@ export interface paths {
             };
             requestBody: {
                 content: {
-                    "application/json": components["schemas"]["UpdateMovieRequest"];
+                    "application/json": components["schemas"]["GrantPermissionRequest"];
                 };
             };
             responses: {
-                /** @description Movie updated successfully */
+                /** @description Permissions granted to the user */
                 200: {
                     headers: {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": {
-                            movie?: components["schemas"]["Movie"];
-                        };
+                        "application/json": components["schemas"]["PermissionsResponse"];
                     };
                 };
                 /** @description Bad request */
@@ -158,56 +1616,379 @@ export interface paths {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": {
-                            error?: string;
-                        };
+                        "application/problem+json": components["schemas"]["Problem"];
                     };
                 };
-                /** @description Movie not found */
+                422: components["responses"]["UnprocessableEntity"];
+                /** @description User not found */
+                404: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/users/{id}/permissions/{code}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /**
+         * Revoke a permission from a user
+         * @description Requires the permissions:admin permission
+         */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    id: number;
+                    code: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Permissions still granted to the user */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["PermissionsResponse"];
+                    };
+                };
+                422: components["responses"]["UnprocessableEntity"];
+                /** @description User not found */
                 404: {
                     headers: {
                         [name: string]: unknown;
                     };
-                    content?: never;
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
                 };
+                401: components["responses"]["Unauthorized"];
+                403: components["responses"]["Forbidden"];
+                default: components["responses"]["Error"];
             };
         };
+        options?: never;
+        head?: never;
+        patch?: never;
         trace?: never;
     };
 }
 export type webhooks = Record<string, never>;
 export interface components {
     schemas: {
+        /** @description RFC 9457 problem details */
+        Problem: {
+            /**
+             * @description URI identifying the problem type, derived from the code
+             * @example urn:problem-type:validation_failed
+             */
+            type: string;
+            /**
+             * @description Short summary of the problem type
+             * @example Bad Request
+             */
+            title: string;
+            /**
+             * @description HTTP status code
+             * @example 400
+             */
+            status: number;
+            /** @description Explanation specific to this occurrence of the problem */
+            detail?: string;
+            /** @description Trace ID of the request, for finding it in the logs */
+            instance?: string;
+            /**
+             * @description Stable machine-readable error code
+             * @enum {string}
+             */
+            code: "bad_request" | "validation_failed" | "not_found" | "unsupported_media_type" | "not_acceptable" | "method_not_allowed" | "edit_conflict" | "conflict" | "invalid_credentials" | "invalid_token" | "authentication_required" | "inactive_account" | "forbidden" | "rate_limit_exceeded" | "internal_error";
+            /** @description Invalid fields mapped to what is wrong with them, only set for validation_failed */
+            errors?: {
+                [key: string]: string;
+            };
+        };
+        /** @description Pagination details, empty when there are no results */
+        Metadata: {
+            /** Format: int32 */
+            current_page?: number;
+            /** Format: int32 */
+            page_size?: number;
+            /** Format: int32 */
+            first_page?: number;
+            /** Format: int32 */
+            last_page?: number;
+            /** Format: int64 */
+            total_records?: number;
+            /** @description Cursor for the next keyset page, omitted on the last page */
+            next_cursor?: string;
+        };
         Movie: {
             /** Format: int64 */
             id: number;
             /** Format: int32 */
             version: number;
             title: string;
-            /** Format: int32 */
+            /**
+             * Format: int32
+             * @description Must not be in the future
+             */
             year: number;
             /** @description Runtime in minutes, formatted as "X min" */
             runtime: string;
             genres: string[];
+            /**
+             * Format: date-time
+             * @description Time the movie was moved to the trash, only set for deleted movies
+             */
+            deletedAt?: string;
+        };
+        SearchResult: {
+            movie: components["schemas"]["Movie"];
+            /**
+             * Format: float
+             * @description Relevance of the movie to the query, higher is better
+             */
+            score: number;
+            /** @description HTML fragment of the title with the matching words wrapped in <mark> tags. The title is HTML-escaped, so the fragment can be rendered as HTML. */
+            snippet: string;
+        };
+        CreateCreditRequest: {
+            /** Format: int64 */
+            personId: number;
+            role: components["schemas"]["CreditRole"];
+            /** @description Name of the character, only for actors */
+            character?: string;
+            /**
+             * Format: int32
+             * @description Position among the credits of the movie, after the existing credits when omitted
+             */
+            billingOrder?: number;
+        };
+        CreateGenreRequest: {
+            slug: string;
+            name: string;
+            /** @description Other spellings of the genre, stored as slugs */
+            aliases?: string[];
         };
         CreateMovieRequest: {
             title: string;
-            /** Format: int32 */
+            /**
+             * Format: int32
+             * @description Must not be in the future
+             */
             year: number;
             /** Format: int32 */
             runtimeMin: number;
+            /** @description Slugs or aliases of genres in the catalogue, stored as slugs */
             genres: string[];
         };
+        CreatePersonRequest: {
+            name: string;
+            /**
+             * Format: int32
+             * @description Must not be in the future
+             */
+            birthYear?: number;
+        };
+        Credit: {
+            /** Format: int64 */
+            id: number;
+            /** Format: int64 */
+            personId: number;
+            personName: string;
+            role: components["schemas"]["CreditRole"];
+            /** @description Name of the character, only set for actors */
+            character?: string;
+            /** Format: int32 */
+            billingOrder: number;
+        };
+        /** @enum {string} */
+        CreditRole: "director" | "writer" | "producer" | "actor" | "composer" | "cinematographer" | "editor";
+        MovieRevision: {
+            /** Format: int32 */
+            version: number;
+            /**
+             * @description The change which produced the revision, snapshot is the state of movies which existed before the history was recorded
+             * @enum {string}
+             */
+            operation: "create" | "update" | "delete" | "restore" | "revert" | "snapshot";
+            movie: components["schemas"]["Movie"];
+            /** @description Fields of the movie changed by the revision */
+            changedFields: string[];
+            /**
+             * Format: int64
+             * @description User who made the change, not set for changes made outside of the API
+             */
+            userId?: number;
+            /** @description Trace ID of the request which made the change */
+            traceId: string;
+            /** Format: date-time */
+            createdAt: string;
+        };
+        Genre: {
+            /** @description Identifies the genre in the genres of movies */
+            slug: string;
+            /** @description Display name */
+            name: string;
+            /** @description Other spellings which are stored as the slug when movies are written */
+            aliases: string[];
+        };
+        Person: {
+            /** Format: int64 */
+            id: number;
+            name: string;
+            /**
+             * Format: int32
+             * @description Omitted when the birth year is unknown
+             */
+            birthYear?: number;
+        };
+        ImportReport: {
+            /** @enum {string} */
+            mode: "all_or_nothing" | "best_effort";
+            /** @description Number of movies inserted */
+            imported: number;
+            /** @description Number of valid rows */
+            accepted: number;
+            /** @description Number of invalid rows */
+            rejected: number;
+            rows: components["schemas"]["ImportRow"][];
+        };
+        ImportRow: {
+            /** @description Line of the body the row starts on */
+            line: number;
+            /** @enum {string} */
+            status: "accepted" | "rejected";
+            /** @description Invalid fields mapped to what is wrong with them, "row" when the row could not be parsed at all */
+            errors?: {
+                [key: string]: string;
+            };
+        };
+        UpdateGenreRequest: {
+            name?: string;
+            /** @description Replaces all the aliases of the genre */
+            aliases?: string[];
+        };
         UpdateMovieRequest: {
             title?: string;
-            /** Format: int32 */
+            /**
+             * Format: int32
+             * @description Must not be in the future
+             */
             year?: number;
             /** Format: int32 */
             runtimeMin?: number;
+            /** @description Slugs or aliases of genres in the catalogue, stored as slugs */
             genres?: string[];
         };
+        UpdatePersonRequest: {
+            name?: string;
+            /**
+             * Format: int32
+             * @description Must not be in the future
+             */
+            birthYear?: number;
+            /** @description Removes the birth year of the person, can not be combined with birthYear */
+            clearBirthYear?: boolean;
+        };
+        User: {
+            /** Format: int64 */
+            id: number;
+            /** Format: date-time */
+            createdAt: string;
+            name: string;
+            email: string;
+            activated: boolean;
+        };
+        RegisterUserRequest: {
+            name: string;
+            email: string;
+            /** Format: password */
+            password: string;
+        };
+        ActivateUserRequest: {
+            token: string;
+        };
+        CreateAuthenticationTokenRequest: {
+            email: string;
+            /** Format: password */
+            password: string;
+        };
+        AuthenticationToken: {
+            /** @description Send as "Authorization: Bearer <token>" */
+            token: string;
+            /** Format: date-time */
+            expiry: string;
+        };
+        GrantPermissionRequest: {
+            /** @enum {string} */
+            code: "movies:write" | "permissions:admin";
+        };
+        PermissionsResponse: {
+            permissions: string[];
+        };
+    };
+    responses: {
+        /** @description Unexpected error */
+        Error: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
+        /** @description The request failed validation, the invalid fields are listed in errors */
+        UnprocessableEntity: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
+        /** @description Missing or invalid authentication token */
+        Unauthorized: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
+        /** @description The user is not activated or lacks the required permission */
+        Forbidden: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
     };
-    responses: never;
     parameters: never;
     requestBodies: never;
     headers: never;
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage"
+	"github.com/zbsss/greenlight/movies/client"
+)
+
+const (
+	backendAPI = "api"
+	backendDB  = "db"
+
+	apiTimeout = 30 * time.Second
+)
+
+// backend manages movies and permissions, either through the API or
+// directly on the database. Both validate input the same way, the database
+// backend uses the services behind the API.
+type backend interface {
+	ListMovies(ctx context.Context, filters service.MovieFilters) ([]movie, error)
+	GetMovie(ctx context.Context, id int64) (movie, error)
+	CreateMovie(ctx context.Context, input service.MovieInput) (movie, error)
+	UpdateMovie(ctx context.Context, id int64, updates service.PartialMovieUpdate) (movie, error)
+	DeleteMovie(ctx context.Context, id int64) error
+
+	GetPermissions(ctx context.Context, userID int64) ([]string, error)
+	GrantPermission(ctx context.Context, userID int64, code string) ([]string, error)
+	RevokePermission(ctx context.Context, userID int64, code string) ([]string, error)
+
+	Close()
+}
+
+func (o *options) openBackend(ctx context.Context) (backend, error) {
+	switch o.backend {
+	case backendAPI:
+		return newAPIBackend(o.apiURL, o.token, o.httpClient)
+	case backendDB:
+		pool, err := o.openPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return newDBBackend(pool), nil
+	}
+	return nil, fmt.Errorf("unsupported backend %q, use api or db", o.backend)
+}
+
+func (o *options) openPool(ctx context.Context) (*pgxpool.Pool, error) {
+	if o.dbDSN == "" {
+		return nil, errors.New("--db-dsn or DB_DSN must be set")
+	}
+
+	pool, err := pgxpool.New(ctx, o.dbDSN)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+type apiBackend struct {
+	api *client.API
+}
+
+func newAPIBackend(baseURL, token string, httpClient client.HttpRequestDoer) (*apiBackend, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: apiTimeout}
+	}
+
+	api, err := client.New(baseURL, client.WithDoer(httpClient), client.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &apiBackend{api: api}, nil
+}
+
+func (b *apiBackend) ListMovies(ctx context.Context, f service.MovieFilters) ([]movie, error) {
+	params := &client.GetV1MoviesParams{
+		Title:      nonZero(f.Title),
+		YearMin:    nonZero(f.YearMin),
+		YearMax:    nonZero(f.YearMax),
+		RuntimeMin: nonZero(f.RuntimeMin),
+		RuntimeMax: nonZero(f.RuntimeMax),
+		PersonId:   nonZero(f.PersonID),
+		Page:       nonZero(f.Page),
+		PageSize:   nonZero(f.PageSize),
+	}
+	if len(f.Genres) > 0 {
+		params.Genres = &f.Genres
+	}
+	if f.Sort != "" {
+		sort := client.GetV1MoviesParamsSort(f.Sort)
+		params.Sort = &sort
+	}
+
+	movies, _, err := b.api.ListMovies(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]movie, 0, len(movies))
+	for _, m := range movies {
+		result = append(result, fromClient(m))
+	}
+	return result, nil
+}
+
+func (b *apiBackend) GetMovie(ctx context.Context, id int64) (movie, error) {
+	m, err := b.api.GetMovie(ctx, id)
+	return fromClient(m), err
+}
+
+func (b *apiBackend) CreateMovie(ctx context.Context, input service.MovieInput) (movie, error) {
+	m, err := b.api.CreateMovie(ctx, client.CreateMovieRequest{
+		Title:      input.Title,
+		Year:       input.Year,
+		RuntimeMin: input.RuntimeMin,
+		Genres:     input.Genres,
+	})
+	return fromClient(m), err
+}
+
+func (b *apiBackend) UpdateMovie(ctx context.Context, id int64, updates service.PartialMovieUpdate) (movie, error) {
+	in := client.UpdateMovieRequest{
+		Title:      updates.Title,
+		Year:       updates.Year,
+		RuntimeMin: updates.RuntimeMin,
+	}
+	if updates.Genres != nil {
+		in.Genres = &updates.Genres
+	}
+
+	var version int32
+	if updates.ExpectedVersion != nil {
+		version = *updates.ExpectedVersion
+	}
+
+	m, err := b.api.UpdateMovie(ctx, id, version, in)
+	return fromClient(m), err
+}
+
+func (b *apiBackend) DeleteMovie(ctx context.Context, id int64) error {
+	return b.api.DeleteMovie(ctx, id)
+}
+
+func (b *apiBackend) GetPermissions(ctx context.Context, userID int64) ([]string, error) {
+	return b.api.GetPermissions(ctx, userID)
+}
+
+func (b *apiBackend) GrantPermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	return b.api.GrantPermission(ctx, userID, client.GrantPermissionRequestCode(code))
+}
+
+func (b *apiBackend) RevokePermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	return b.api.RevokePermission(ctx, userID, code)
+}
+
+func (b *apiBackend) Close() {}
+
+func fromClient(m client.Movie) movie {
+	// The API formats the runtime as "X min".
+	var runtime int32
+	_, _ = fmt.Sscanf(m.Runtime, "%d min", &runtime)
+
+	return movie{
+		ID:         m.Id,
+		Title:      m.Title,
+		Year:       m.Year,
+		RuntimeMin: runtime,
+		Genres:     m.Genres,
+		Version:    m.Version,
+	}
+}
+
+func nonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+type dbBackend struct {
+	pool *pgxpool.Pool
+	ms   *service.MovieService
+	us   *service.UserService
+}
+
+func newDBBackend(pool *pgxpool.Pool) *dbBackend {
+	store := storage.NewStore(pool)
+	return &dbBackend{
+		pool: pool,
+		ms:   service.New(store),
+		// Managing permissions doesn't send notifications.
+		us: service.NewUserService(store, nil),
+	}
+}
+
+func (b *dbBackend) ListMovies(ctx context.Context, f service.MovieFilters) ([]movie, error) {
+	movies, _, err := b.ms.ListMovies(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]movie, 0, len(movies))
+	for _, m := range movies {
+		result = append(result, fromService(m))
+	}
+	return result, nil
+}
+
+func (b *dbBackend) GetMovie(ctx context.Context, id int64) (movie, error) {
+	m, err := b.ms.GetMovie(ctx, id)
+	if err != nil {
+		return movie{}, err
+	}
+	return fromService(m), nil
+}
+
+func (b *dbBackend) CreateMovie(ctx context.Context, input service.MovieInput) (movie, error) {
+	m, err := b.ms.CreateMovie(ctx, input)
+	if err != nil {
+		return movie{}, err
+	}
+	return fromService(m), nil
+}
+
+func (b *dbBackend) UpdateMovie(ctx context.Context, id int64, updates service.PartialMovieUpdate) (movie, error) {
+	m, err := b.ms.UpdateMovie(ctx, id, updates)
+	if err != nil {
+		return movie{}, err
+	}
+	return fromService(m), nil
+}
+
+func (b *dbBackend) DeleteMovie(ctx context.Context, id int64) error {
+	return b.ms.DeleteMovie(ctx, id)
+}
+
+func (b *dbBackend) GetPermissions(ctx context.Context, userID int64) ([]string, error) {
+	return b.us.GetPermissions(ctx, userID)
+}
+
+func (b *dbBackend) GrantPermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	return b.us.GrantPermission(ctx, userID, code)
+}
+
+func (b *dbBackend) RevokePermission(ctx context.Context, userID int64, code string) ([]string, error) {
+	return b.us.RevokePermission(ctx, userID, code)
+}
+
+func (b *dbBackend) Close() {
+	b.pool.Close()
+}
+
+func fromService(m *service.Movie) movie {
+	return movie{
+		ID:         m.ID,
+		Title:      m.Title,
+		Year:       m.Year,
+		RuntimeMin: m.RuntimeMin,
+		Genres:     m.Genres,
+		Version:    m.Version,
+	}
+}
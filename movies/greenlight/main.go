@@ -0,0 +1,107 @@
+// Command greenlight administers the movies catalogue. Movies and
+// permissions are managed, and sample movies seeded, through the HTTP API or
+// directly on the database. Migrations always work on the database.
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/zbsss/greenlight/movies/client"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+const defaultAPIURL = "http://localhost:400"
+
+// options are the global flags shared by all commands.
+type options struct {
+	backend string
+	apiURL  string
+	token   string
+	dbDSN   string
+	output  string
+
+	// httpClient sends the requests of the API backend, tests replace it.
+	httpClient client.HttpRequestDoer
+}
+
+func newRootCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "greenlight",
+		Short:         "Administer the movies catalogue",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			if _, ok := formats[opts.output]; !ok {
+				return fmt.Errorf("unsupported output format %q, use one of %v", opts.output, formatNames())
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&opts.backend, "backend", cmp.Or(os.Getenv("GREENLIGHT_BACKEND"), backendAPI),
+		"Where movies and permissions are managed (api|db) (env GREENLIGHT_BACKEND)")
+	flags.StringVar(&opts.apiURL, "api-url", cmp.Or(os.Getenv("GREENLIGHT_API_URL"), defaultAPIURL), "Base URL of the API (env GREENLIGHT_API_URL)")
+	flags.StringVar(&opts.token, "token", os.Getenv("GREENLIGHT_TOKEN"), "Authentication token for the API (env GREENLIGHT_TOKEN)")
+	flags.StringVar(&opts.dbDSN, "db-dsn", os.Getenv("DB_DSN"), "PostgreSQL DSN (env DB_DSN)")
+	flags.StringVarP(&opts.output, "output", "o", formatTable, "Output format (table|json|csv)")
+
+	cmd.AddCommand(
+		newMoviesCmd(opts),
+		newPermissionsCmd(opts),
+		newMigrateCmd(opts),
+		newSeedCmd(opts),
+	)
+
+	return cmd
+}
+
+// describeError adds the field errors of validation failures, which are
+// left out of their messages.
+func describeError(err error) string {
+	var fields map[string]string
+
+	var problem *client.ProblemError
+	var validationErr validator.ValidationError
+	switch {
+	case errors.As(err, &problem):
+		fields = problem.FieldErrors()
+	case errors.As(err, &validationErr):
+		fields = validationErr.Errors
+	}
+
+	msg := err.Error()
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		msg += fmt.Sprintf("\n  %s: %s", field, fields[field])
+	}
+	return msg
+}
+
+func run(ctx context.Context, opts *options, args []string, stdout, stderr io.Writer) int {
+	cmd := newRootCmd(opts)
+	cmd.SetArgs(args)
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(stderr, "Error:", describeError(err))
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, &options{}, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
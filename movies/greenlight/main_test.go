@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/movies/backend/api/apitest"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/seed"
+	"github.com/zbsss/greenlight/pkg/srvx/testserver"
+)
+
+// testEnv is an API server backed by mocks and a user who may write movies
+// and administer permissions.
+type testEnv struct {
+	ts     *testserver.Server
+	token  string
+	userID int64
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	env := apitest.New(t)
+	user, token := env.CreateUser(t, "admin@example.com", true, service.PermissionCodes...)
+
+	ts := testserver.New(env.Handler)
+	t.Cleanup(ts.Close)
+
+	return &testEnv{ts: ts, token: token, userID: user.ID}
+}
+
+// run runs the command against the API and returns its exit code, stdout
+// and stderr.
+func (e *testEnv) run(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	opts := &options{httpClient: e.ts.Client()}
+	args = append([]string{"--api-url", e.ts.URL, "--token", e.token}, args...)
+
+	code := run(context.Background(), opts, args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func (e *testEnv) runOK(t *testing.T, args ...string) string {
+	t.Helper()
+
+	code, stdout, stderr := e.run(args...)
+	if code != 0 {
+		t.Fatalf("greenlight %s exited with %d: %s", strings.Join(args, " "), code, stderr)
+	}
+	return stdout
+}
+
+func TestMovies(t *testing.T) {
+	e := newTestEnv(t)
+
+	out := e.runOK(t, "movies", "create", "-o", "json",
+		"--title", "Moana", "--year", "2016", "--runtime", "107", "--genres", "animation,adventure")
+
+	var created movie
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", out, err)
+	}
+	if created.Title != "Moana" || created.RuntimeMin != 107 || len(created.Genres) != 2 {
+		t.Fatalf("unexpected movie %+v", created)
+	}
+	id := strconv.FormatInt(created.ID, 10)
+
+	out = e.runOK(t, "movies", "get", id)
+	want := "ID  TITLE  YEAR  RUNTIME  GENRES               VERSION\n" +
+		id + "   Moana  2016  107      animation,adventure  1\n"
+	if out != want {
+		t.Errorf("expected table\n%s\ngot\n%s", want, out)
+	}
+
+	e.runOK(t, "movies", "update", id, "--title", "Moana 2", "--version", "1")
+
+	code, _, stderr := e.run("movies", "update", id, "--year", "2024", "--version", "1")
+	if code != 1 || !strings.Contains(stderr, "edit_conflict") {
+		t.Errorf("expected an edit conflict for a stale version; got %d %q", code, stderr)
+	}
+
+	out = e.runOK(t, "movies", "list", "--title", "moana", "-o", "csv")
+	want = "id,title,year,runtime,genres,version\n" +
+		id + ",Moana 2,2016,107,\"animation,adventure\",2\n"
+	if out != want {
+		t.Errorf("expected CSV\n%s\ngot\n%s", want, out)
+	}
+
+	e.runOK(t, "movies", "delete", id)
+
+	code, _, stderr = e.run("movies", "get", id)
+	if code != 1 || !strings.Contains(stderr, "not_found") {
+		t.Errorf("expected not_found for a deleted movie; got %d %q", code, stderr)
+	}
+}
+
+func TestMoviesValidation(t *testing.T) {
+	e := newTestEnv(t)
+
+	code, stdout, stderr := e.run("movies", "create", "--title", "Moana", "--year", "2016", "--genres", "animation")
+	if code != 1 || stdout != "" {
+		t.Fatalf("expected the create to fail; got %d %q", code, stdout)
+	}
+	if !strings.Contains(stderr, "validation_failed") || !strings.Contains(stderr, "\n  runtimeMin: ") {
+		t.Errorf("expected the field errors; got %q", stderr)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	e := newTestEnv(t)
+	userID := strconv.FormatInt(e.userID, 10)
+
+	out := e.runOK(t, "permissions", "revoke", userID, service.PermissionMoviesWrite, "-o", "json")
+	if strings.TrimSpace(out) != `[
+  "permissions:admin"
+]` {
+		t.Errorf("unexpected permissions %s", out)
+	}
+
+	out = e.runOK(t, "permissions", "grant", userID, service.PermissionMoviesWrite)
+	if out != "PERMISSION\nmovies:write\npermissions:admin\n" {
+		t.Errorf("unexpected permissions %q", out)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	e := newTestEnv(t)
+
+	out := e.runOK(t, "seed", "-o", "json")
+
+	var created []movie
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", out, err)
+	}
+	if len(created) != len(seed.Movies) {
+		t.Errorf("expected %d movies; got %d", len(seed.Movies), len(created))
+	}
+}
+
+func TestInvalidFlags(t *testing.T) {
+	e := newTestEnv(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "output format", args: []string{"movies", "list", "-o", "yaml"}, wantErr: `unsupported output format "yaml"`},
+		{name: "backend", args: []string{"movies", "list", "--backend", "file"}, wantErr: `unsupported backend "file"`},
+		{name: "id", args: []string{"movies", "get", "one"}, wantErr: `invalid id "one"`},
+		{name: "db backend without dsn", args: []string{"movies", "list", "--backend", "db", "--db-dsn", ""}, wantErr: "DB_DSN must be set"},
+		{name: "migrate without dsn", args: []string{"migrate", "version", "--db-dsn", ""}, wantErr: "DB_DSN must be set"},
+		{name: "down with all and n", args: []string{"migrate", "down", "2", "--all", "--db-dsn", "postgres://localhost"}, wantErr: "--all can't be combined with N"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := e.run(tt.args...)
+			if code != 1 || !strings.Contains(stderr, tt.wantErr) {
+				t.Errorf("expected error %q; got %d %q", tt.wantErr, code, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/zbsss/greenlight/movies/backend/storage/migrations"
+)
+
+func newMigrateCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the database schema",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.dbDSN == "" {
+				return errors.New("--db-dsn or DB_DSN must be set")
+			}
+			return cmd.Root().PersistentPreRunE(cmd, args)
+		},
+	}
+
+	cmd.AddCommand(
+		newMigrateUpCmd(opts),
+		newMigrateDownCmd(opts),
+		newMigrateVersionCmd(opts),
+		newMigrateForceCmd(opts),
+	)
+
+	return cmd
+}
+
+func newMigrateUpCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			err := migrations.Up(opts.dbDSN)
+			if err != nil && !errors.Is(err, migrations.ErrNoChange) {
+				return err
+			}
+			return printVersion(cmd, opts)
+		},
+	}
+}
+
+func newMigrateDownCmd(opts *options) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "down [N]",
+		Short: "Roll back the last N migrations, 1 by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps := 1
+			switch {
+			case all && len(args) > 0:
+				return errors.New("--all can't be combined with N")
+			case all:
+				steps = 0
+			case len(args) > 0:
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 1 {
+					return fmt.Errorf("invalid number of migrations %q", args[0])
+				}
+				steps = n
+			}
+
+			err := migrations.Down(opts.dbDSN, steps)
+			if err != nil && !errors.Is(err, migrations.ErrNoChange) {
+				return err
+			}
+			return printVersion(cmd, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Roll back all migrations, dropping every table")
+
+	return cmd
+}
+
+func newMigrateVersionCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Show the version of the schema",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return printVersion(cmd, opts)
+		},
+	}
+}
+
+func newMigrateForceCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force VERSION",
+		Short: "Set the version of the schema without migrating, after fixing a failed migration by hand",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[0])
+			if err != nil || version < -1 {
+				return fmt.Errorf("invalid version %q", args[0])
+			}
+
+			if err := migrations.Force(opts.dbDSN, version); err != nil {
+				return err
+			}
+			return printVersion(cmd, opts)
+		},
+	}
+}
+
+type schemaVersion struct {
+	Version uint `json:"version"`
+	Latest  uint `json:"latest"`
+	Dirty   bool `json:"dirty"`
+}
+
+func printVersion(cmd *cobra.Command, opts *options) error {
+	var v schemaVersion
+
+	var err error
+	v.Version, v.Dirty, err = migrations.Version(opts.dbDSN)
+	if err != nil && !errors.Is(err, migrations.ErrNilVersion) {
+		return err
+	}
+
+	v.Latest, err = migrations.Latest()
+	if err != nil {
+		return err
+	}
+
+	return opts.print(cmd.OutOrStdout(), table{
+		header: []string{"version", "latest", "dirty"},
+		rows:   [][]string{{strconv.FormatUint(uint64(v.Version), 10), strconv.FormatUint(uint64(v.Latest), 10), strconv.FormatBool(v.Dirty)}},
+		value:  v,
+	})
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/zbsss/greenlight/movies/backend/service"
+)
+
+func newMoviesCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "movies",
+		Short: "Manage movies",
+	}
+
+	cmd.AddCommand(
+		newMoviesListCmd(opts),
+		newMoviesGetCmd(opts),
+		newMoviesCreateCmd(opts),
+		newMoviesUpdateCmd(opts),
+		newMoviesDeleteCmd(opts),
+	)
+
+	return cmd
+}
+
+func newMoviesListCmd(opts *options) *cobra.Command {
+	var filters service.MovieFilters
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List movies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			movies, err := b.ListMovies(cmd.Context(), filters)
+			if err != nil {
+				return err
+			}
+
+			return opts.print(cmd.OutOrStdout(), moviesTable(movies))
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&filters.Title, "title", "", "Only list movies whose title contains this value")
+	flags.StringSliceVar(&filters.Genres, "genres", nil, "Only list movies which have all of these genres, comma separated")
+	flags.Int32Var(&filters.YearMin, "year-min", 0, "Only list movies released in or after this year")
+	flags.Int32Var(&filters.YearMax, "year-max", 0, "Only list movies released in or before this year")
+	flags.Int32Var(&filters.RuntimeMin, "runtime-min", 0, "Only list movies at least this many minutes long")
+	flags.Int32Var(&filters.RuntimeMax, "runtime-max", 0, "Only list movies at most this many minutes long")
+	flags.Int64Var(&filters.PersonID, "person", 0, "Only list movies on which the person with this ID is credited")
+	flags.StringVar(&filters.Sort, "sort", service.DefaultSort, "Sort order, prefix with \"-\" for descending")
+	flags.Int32Var(&filters.Page, "page", service.DefaultPage, "Page number")
+	flags.Int32Var(&filters.PageSize, "page-size", service.DefaultPageSize, "Movies per page")
+
+	return cmd
+}
+
+func newMoviesGetCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get ID",
+		Short: "Show a movie",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			m, err := b.GetMovie(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			return opts.print(cmd.OutOrStdout(), movieTable(m))
+		},
+	}
+}
+
+func newMoviesCreateCmd(opts *options) *cobra.Command {
+	var input service.MovieInput
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a movie",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			m, err := b.CreateMovie(cmd.Context(), input)
+			if err != nil {
+				return err
+			}
+
+			return opts.print(cmd.OutOrStdout(), movieTable(m))
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&input.Title, "title", "", "Title")
+	flags.Int32Var(&input.Year, "year", 0, "Release year")
+	flags.Int32Var(&input.RuntimeMin, "runtime", 0, "Runtime in minutes")
+	flags.StringSliceVar(&input.Genres, "genres", nil, "Genres, comma separated")
+
+	return cmd
+}
+
+func newMoviesUpdateCmd(opts *options) *cobra.Command {
+	var (
+		title           string
+		year, runtime   int32
+		genres          []string
+		expectedVersion int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update ID",
+		Short: "Change the fields of a movie given as flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Only the flags which were given are updated.
+			var updates service.PartialMovieUpdate
+			flags := cmd.Flags()
+			if flags.Changed("title") {
+				updates.Title = &title
+			}
+			if flags.Changed("year") {
+				updates.Year = &year
+			}
+			if flags.Changed("runtime") {
+				updates.RuntimeMin = &runtime
+			}
+			if flags.Changed("genres") {
+				updates.Genres = genres
+			}
+			if flags.Changed("version") {
+				updates.ExpectedVersion = &expectedVersion
+			}
+
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			m, err := b.UpdateMovie(cmd.Context(), id, updates)
+			if err != nil {
+				return err
+			}
+
+			return opts.print(cmd.OutOrStdout(), movieTable(m))
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&title, "title", "", "Title")
+	flags.Int32Var(&year, "year", 0, "Release year")
+	flags.Int32Var(&runtime, "runtime", 0, "Runtime in minutes")
+	flags.StringSliceVar(&genres, "genres", nil, "Genres, comma separated, replacing the current ones")
+	flags.Int32Var(&expectedVersion, "version", 0, "Only update the movie if it is still at this version")
+
+	return cmd
+}
+
+func newMoviesDeleteCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Move a movie to the trash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			if err := b.DeleteMovie(cmd.Context(), id); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "movie %d moved to the trash\n", id)
+			return nil
+		},
+	}
+}
+
+func movieTable(m movie) table {
+	t := moviesTable([]movie{m})
+	t.value = m
+	return t
+}
+
+func parseID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("invalid id %q", arg)
+	}
+	return id, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// table is the output of a command. JSON output encodes value, the other
+// formats print the rows under the header.
+type table struct {
+	header []string
+	rows   [][]string
+	value  any
+}
+
+var formats = map[string]func(w io.Writer, t table) error{
+	formatTable: writeTable,
+	formatJSON:  writeJSON,
+	formatCSV:   writeCSV,
+}
+
+func formatNames() []string {
+	return slices.Sorted(maps.Keys(formats))
+}
+
+func (o *options) print(w io.Writer, t table) error {
+	return formats[o.output](w, t)
+}
+
+func writeTable(w io.Writer, t table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(t.header, "\t")))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeJSON(w io.Writer, t table) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t.value)
+}
+
+func writeCSV(w io.Writer, t table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(t.rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// movie is how the commands print movies, whichever backend they came from.
+type movie struct {
+	ID         int64    `json:"id"`
+	Title      string   `json:"title"`
+	Year       int32    `json:"year"`
+	RuntimeMin int32    `json:"runtimeMin"`
+	Genres     []string `json:"genres"`
+	Version    int32    `json:"version"`
+}
+
+func moviesTable(movies []movie) table {
+	t := table{
+		header: []string{"id", "title", "year", "runtime", "genres", "version"},
+		value:  movies,
+	}
+	for _, m := range movies {
+		t.rows = append(t.rows, []string{
+			strconv.FormatInt(m.ID, 10),
+			m.Title,
+			strconv.Itoa(int(m.Year)),
+			strconv.Itoa(int(m.RuntimeMin)),
+			strings.Join(m.Genres, ","),
+			strconv.Itoa(int(m.Version)),
+		})
+	}
+	return t
+}
+
+func permissionsTable(permissions []string) table {
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	t := table{
+		header: []string{"permission"},
+		value:  permissions,
+	}
+	for _, p := range permissions {
+		t.rows = append(t.rows, []string{p})
+	}
+	return t
+}
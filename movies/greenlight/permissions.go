@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zbsss/greenlight/movies/backend/service"
+)
+
+func newPermissionsCmd(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Manage the permissions of users",
+	}
+
+	codes := strings.Join(service.PermissionCodes, ", ")
+
+	cmd.AddCommand(
+		newPermissionsSubCmd(opts, "list USER_ID", "List the permissions of a user", 1,
+			func(ctx context.Context, b backend, userID int64, _ string) ([]string, error) {
+				return b.GetPermissions(ctx, userID)
+			}),
+		newPermissionsSubCmd(opts, "grant USER_ID CODE", "Grant a permission ("+codes+") to a user", 2,
+			func(ctx context.Context, b backend, userID int64, code string) ([]string, error) {
+				return b.GrantPermission(ctx, userID, code)
+			}),
+		newPermissionsSubCmd(opts, "revoke USER_ID CODE", "Take a permission away from a user", 2,
+			func(ctx context.Context, b backend, userID int64, code string) ([]string, error) {
+				return b.RevokePermission(ctx, userID, code)
+			}),
+	)
+
+	return cmd
+}
+
+// newPermissionsSubCmd creates a command which takes a user ID, and a
+// permission code when nargs is 2, and prints the resulting permissions.
+func newPermissionsSubCmd(
+	opts *options, use, short string, nargs int,
+	fn func(ctx context.Context, b backend, userID int64, code string) ([]string, error),
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(nargs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var code string
+			if nargs > 1 {
+				code = args[1]
+			}
+
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			permissions, err := fn(cmd.Context(), b, userID, code)
+			if err != nil {
+				return err
+			}
+
+			return opts.print(cmd.OutOrStdout(), permissionsTable(permissions))
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/zbsss/greenlight/movies/backend/service"
+	"github.com/zbsss/greenlight/movies/backend/storage/seed"
+)
+
+func newSeedCmd(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed",
+		Short: "Add the sample movies which development databases start with",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			b, err := opts.openBackend(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer b.Close()
+
+			created := make([]movie, 0, len(seed.Movies))
+			for _, m := range seed.Movies {
+				c, err := b.CreateMovie(cmd.Context(), service.MovieInput{
+					Title:      m.Title,
+					Year:       m.Year,
+					RuntimeMin: m.RuntimeMin,
+					Genres:     m.Genres,
+				})
+				if err != nil {
+					return err
+				}
+				created = append(created, c)
+			}
+
+			return opts.print(cmd.OutOrStdout(), moviesTable(created))
+		},
+	}
+}
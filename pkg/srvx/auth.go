@@ -0,0 +1,70 @@
+package srvx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Authenticator resolves bearer tokens to the users they were issued to.
+type Authenticator interface {
+	// Authenticate returns the user the token belongs to. ok is false when
+	// the token is unknown or has expired.
+	Authenticate(ctx context.Context, token string) (user any, ok bool, err error)
+}
+
+// authenticate loads the user identified by the "Authorization: Bearer"
+// header into the request context. Requests without the header are passed
+// on anonymously, requests with a bad token are rejected.
+//
+// Rejected requests take a token from the bucket of their IP address when a
+// limiter is given, so that guessing tokens is throttled like anonymous
+// requests are.
+func authenticate(auth Authenticator, limiter RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The response depends on who is asking, so it must not be
+			// cached for anyone else.
+			w.Header().Add("Vary", "Authorization")
+
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scheme, token, ok := strings.Cut(header, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				rejectToken(w, r, limiter)
+				return
+			}
+
+			user, ok, err := auth.Authenticate(r.Context(), token)
+			if err != nil {
+				ErrServer(w, r, err)
+				return
+			}
+			if !ok {
+				rejectToken(w, r, limiter)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), userKey, user)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func rejectToken(w http.ResponseWriter, r *http.Request, limiter RateLimiter) {
+	if limiter != nil && !allowRequest(w, r, limiter, ipKey(r)) {
+		return
+	}
+	ErrInvalidAuthenticationToken(w, r)
+}
+
+// User returns the authenticated user from the given context. ok is false
+// for anonymous requests.
+func User[T any](ctx context.Context) (user T, ok bool) {
+	user, ok = ctx.Value(userKey).(T)
+	return user, ok
+}
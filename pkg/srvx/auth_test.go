@@ -0,0 +1,129 @@
+package srvx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type stubAuthenticator map[string]string
+
+func (sa stubAuthenticator) Authenticate(_ context.Context, token string) (any, bool, error) {
+	if token == "broken" {
+		return nil, false, errors.New("database is down")
+	}
+
+	user, ok := sa[token]
+	return user, ok, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	auth := stubAuthenticator{"valid-token": "alice"}
+
+	tests := []struct {
+		name           string
+		authorization  string
+		wantStatus     int
+		wantUser       string
+		wantAuthHeader bool
+	}{
+		{
+			name:       "anonymous",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "valid token",
+			authorization: "Bearer valid-token",
+			wantStatus:    http.StatusOK,
+			wantUser:      "alice",
+		},
+		{
+			name:          "scheme is case-insensitive",
+			authorization: "bearer valid-token",
+			wantStatus:    http.StatusOK,
+			wantUser:      "alice",
+		},
+		{
+			name:           "unknown token",
+			authorization:  "Bearer other-token",
+			wantStatus:     http.StatusUnauthorized,
+			wantAuthHeader: true,
+		},
+		{
+			name:           "wrong scheme",
+			authorization:  "Basic dXNlcjpwYXNz",
+			wantStatus:     http.StatusUnauthorized,
+			wantAuthHeader: true,
+		},
+		{
+			name:           "missing token",
+			authorization:  "Bearer",
+			wantStatus:     http.StatusUnauthorized,
+			wantAuthHeader: true,
+		},
+		{
+			name:          "authenticator error",
+			authorization: "Bearer broken",
+			wantStatus:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser string
+			next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				gotUser, _ = User[string](r.Context())
+			})
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			authenticate(auth, nil)(next).ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if gotUser != tt.wantUser {
+				t.Errorf("expected user %q, got %q", tt.wantUser, gotUser)
+			}
+			if got := w.Header().Get("WWW-Authenticate"); (got != "") != tt.wantAuthHeader {
+				t.Errorf("unexpected WWW-Authenticate header %q", got)
+			}
+			if got := w.Header().Get("Vary"); got != "Authorization" {
+				t.Errorf("expected Vary header to be Authorization, got %q", got)
+			}
+		})
+	}
+}
+
+func TestAuthenticateLimitsBadTokens(t *testing.T) {
+	auth := stubAuthenticator{"valid-token": "alice"}
+	h := authenticate(auth, NewMemoryRateLimiter(1, 2))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	send := func(token string) int {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	for i, want := range []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests} {
+		if got := send("guess"); got != want {
+			t.Errorf("guess %d: expected status %d, got %d", i, want, got)
+		}
+	}
+
+	// Valid tokens are limited per user, not by the IP address.
+	if got := send("valid-token"); got != http.StatusNoContent {
+		t.Errorf("expected valid token to be accepted, got %d", got)
+	}
+}
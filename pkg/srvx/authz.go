@@ -0,0 +1,43 @@
+package srvx
+
+import "net/http"
+
+// Principal is implemented by the users returned from an Authenticator so
+// that handlers can be restricted to users with the right permissions.
+type Principal interface {
+	IsActivated() bool
+	HasPermission(code string) bool
+}
+
+// RequireActivatedUser rejects anonymous requests and requests from users
+// who have not activated their account yet.
+func RequireActivatedUser(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, ok := User[Principal](r.Context())
+		if !ok {
+			ErrAuthenticationRequired(w, r)
+			return
+		}
+
+		if !user.IsActivated() {
+			ErrInactiveAccount(w, r)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// RequirePermission only lets activated users who have been granted the
+// permission through to next.
+func RequirePermission(code string, next http.HandlerFunc) http.HandlerFunc {
+	return RequireActivatedUser(func(w http.ResponseWriter, r *http.Request) {
+		user, _ := User[Principal](r.Context())
+		if !user.HasPermission(code) {
+			ErrForbidden(w, r)
+			return
+		}
+
+		next(w, r)
+	})
+}
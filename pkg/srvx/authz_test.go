@@ -0,0 +1,73 @@
+package srvx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+type testPrincipal struct {
+	activated   bool
+	permissions []string
+}
+
+func (p testPrincipal) IsActivated() bool {
+	return p.activated
+}
+
+func (p testPrincipal) HasPermission(code string) bool {
+	return slices.Contains(p.permissions, code)
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       Principal
+		wantStatus int
+	}{
+		{
+			name:       "anonymous",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "inactive user",
+			user:       testPrincipal{permissions: []string{"movies:write"}},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "missing permission",
+			user:       testPrincipal{activated: true, permissions: []string{"movies:read"}},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "has permission",
+			user:       testPrincipal{activated: true, permissions: []string{"movies:read", "movies:write"}},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.user != nil {
+				r = r.WithContext(context.WithValue(r.Context(), userKey, tt.user))
+			}
+			w := httptest.NewRecorder()
+
+			RequirePermission("movies:write", next)(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.user == nil && w.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Errorf("expected WWW-Authenticate header for anonymous request, got %q", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package srvx
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	defaultCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	defaultCORSHeaders = []string{"Authorization", "Content-Type"}
+)
+
+// CORSConfig controls which browser origins may call the API. No CORS
+// headers are sent when AllowedOrigins is empty.
+type CORSConfig struct {
+	// AllowedOrigins are exact origins such as "https://app.example.com" or
+	// patterns with a wildcard subdomain such as "https://*.example.com".
+	// "*" allows every origin, it can not be combined with AllowCredentials.
+	AllowedOrigins []string
+	// AllowedMethods defaults to GET, POST, PUT, PATCH and DELETE.
+	AllowedMethods []string
+	// AllowedHeaders defaults to Authorization and Content-Type.
+	AllowedHeaders []string
+	// ExposedHeaders are response headers scripts may read, e.g. X-Trace-ID.
+	ExposedHeaders   []string
+	MaxAge           time.Duration
+	AllowCredentials bool
+}
+
+func (c CORSConfig) validate() error {
+	// Any site could otherwise make requests with the cookies or the
+	// credentials of the user.
+	if c.AllowCredentials && slices.Contains(c.AllowedOrigins, "*") {
+		return errors.New(`cors: the "*" origin can not be combined with credentials`)
+	}
+	return nil
+}
+
+func (c CORSConfig) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, pattern := range c.AllowedOrigins {
+		pattern = strings.ToLower(pattern)
+
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+
+		prefix, suffix, ok := strings.Cut(pattern, "*")
+		if !ok || !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+			continue
+		}
+
+		// The wildcard stands for one or more subdomain labels and must not
+		// swallow the scheme, a port or a path.
+		sub := origin[len(prefix) : len(origin)-len(suffix)]
+		if len(origin) > len(prefix)+len(suffix) && !strings.ContainsAny(sub, "/:") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cors adds the CORS headers for trusted origins and answers preflight
+// requests without passing them on.
+func cors(cfg CORSConfig) func(http.Handler) http.Handler {
+	methods := strings.Join(orDefault(cfg.AllowedMethods, defaultCORSMethods), ", ")
+	headers := strings.Join(orDefault(cfg.AllowedHeaders, defaultCORSHeaders), ", ")
+	exposed := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The response differs between origins, caches must keep them apart.
+			w.Header().Add("Vary", "Origin")
+
+			origin := r.Header.Get("Origin")
+			if origin == "" || !cfg.allowOrigin(origin) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			isPreflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if !isPreflight {
+				if exposed != "" {
+					w.Header().Set("Access-Control-Expose-Headers", exposed)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", methods)
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+			if cfg.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", maxAge)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+func orDefault(values, defaults []string) []string {
+	if len(values) == 0 {
+		return defaults
+	}
+	return values
+}
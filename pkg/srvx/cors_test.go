@@ -0,0 +1,172 @@
+package srvx
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORSAllowOrigin(t *testing.T) {
+	cfg := CORSConfig{AllowedOrigins: []string{"http://localhost:3000", "https://*.example.com"}}
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{origin: "http://localhost:3000", want: true},
+		{origin: "http://localhost:3001", want: false},
+		{origin: "https://app.example.com", want: true},
+		{origin: "https://staging.app.example.com", want: true},
+		{origin: "https://APP.Example.com", want: true},
+		{origin: "https://example.com", want: false},
+		{origin: "http://app.example.com", want: false},
+		{origin: "https://app.example.com:8443", want: false},
+		{origin: "https://evil.com/.example.com", want: false},
+		{origin: "https://evilexample.com", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := cfg.allowOrigin(tt.origin); got != tt.want {
+			t.Errorf("allowOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+		}
+	}
+}
+
+func TestCORS(t *testing.T) {
+	cfg := CORSConfig{
+		AllowedOrigins:   []string{"https://*.example.com"},
+		ExposedHeaders:   []string{"X-Trace-ID"},
+		MaxAge:           time.Hour,
+		AllowCredentials: true,
+	}
+
+	tests := []struct {
+		name            string
+		method          string
+		origin          string
+		requestMethod   string
+		wantStatus      int
+		wantAllowOrigin string
+		wantExposed     string
+		wantMethods     string
+		wantMaxAge      string
+	}{
+		{
+			name:       "same origin",
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "untrusted origin",
+			method:     http.MethodGet,
+			origin:     "https://evil.com",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:            "trusted origin",
+			method:          http.MethodGet,
+			origin:          "https://app.example.com",
+			wantStatus:      http.StatusOK,
+			wantAllowOrigin: "https://app.example.com",
+			wantExposed:     "X-Trace-ID",
+		},
+		{
+			name:            "preflight",
+			method:          http.MethodOptions,
+			origin:          "https://app.example.com",
+			requestMethod:   http.MethodPatch,
+			wantStatus:      http.StatusNoContent,
+			wantAllowOrigin: "https://app.example.com",
+			wantMethods:     "GET, POST, PUT, PATCH, DELETE",
+			wantMaxAge:      "3600",
+		},
+		{
+			name:            "options without request method is not a preflight",
+			method:          http.MethodOptions,
+			origin:          "https://app.example.com",
+			wantStatus:      http.StatusOK,
+			wantAllowOrigin: "https://app.example.com",
+			wantExposed:     "X-Trace-ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			r := httptest.NewRequest(tt.method, "/", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			if tt.requestMethod != "" {
+				r.Header.Set("Access-Control-Request-Method", tt.requestMethod)
+			}
+			w := httptest.NewRecorder()
+
+			cors(cfg)(next).ServeHTTP(w, r)
+
+			h := w.Header()
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if got := h.Get("Access-Control-Allow-Origin"); got != tt.wantAllowOrigin {
+				t.Errorf("expected Access-Control-Allow-Origin %q, got %q", tt.wantAllowOrigin, got)
+			}
+			if got := h.Get("Access-Control-Expose-Headers"); got != tt.wantExposed {
+				t.Errorf("expected Access-Control-Expose-Headers %q, got %q", tt.wantExposed, got)
+			}
+			if got := h.Get("Access-Control-Allow-Methods"); got != tt.wantMethods {
+				t.Errorf("expected Access-Control-Allow-Methods %q, got %q", tt.wantMethods, got)
+			}
+			if got := h.Get("Access-Control-Max-Age"); got != tt.wantMaxAge {
+				t.Errorf("expected Access-Control-Max-Age %q, got %q", tt.wantMaxAge, got)
+			}
+			if got := h.Get("Vary"); got != "Origin" {
+				t.Errorf("expected Vary to start with Origin, got %q", got)
+			}
+			if tt.wantAllowOrigin != "" && h.Get("Access-Control-Allow-Credentials") != "true" {
+				t.Error("expected Access-Control-Allow-Credentials to be true")
+			}
+		})
+	}
+}
+
+func TestCORSAnyOrigin(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {})
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Origin", "https://anywhere.test")
+	w := httptest.NewRecorder()
+
+	cors(CORSConfig{AllowedOrigins: []string{"*"}})(next).ServeHTTP(w, r)
+
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected Access-Control-Allow-Origin *, got %q", got)
+	}
+}
+
+func TestNewServerRejectsAnyOriginWithCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     CORSConfig
+		wantErr bool
+	}{
+		{name: "any origin", cfg: CORSConfig{AllowedOrigins: []string{"*"}}},
+		{name: "credentials", cfg: CORSConfig{AllowedOrigins: []string{"https://*.example.com"}, AllowCredentials: true}},
+		{name: "any origin with credentials", cfg: CORSConfig{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewServer(Config{CORS: tt.cfg}, http.NotFoundHandler(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
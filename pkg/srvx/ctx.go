@@ -5,4 +5,6 @@ type ctxKey string
 const (
 	traceIDKey       ctxKey = "traceID"
 	requestLoggerKey ctxKey = "requestLogger"
+	userKey          ctxKey = "user"
+	routeKey         ctxKey = "route"
 )
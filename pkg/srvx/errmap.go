@@ -0,0 +1,87 @@
+package srvx
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+// ErrorMapper translates the errors returned by JSON handlers to problems.
+// Mappings are tried in the order they were added, errors which match none
+// of them are reported as internal server errors.
+type ErrorMapper struct {
+	mappings []func(err error) (Problem, bool)
+}
+
+// NewErrorMapper creates a mapper which already knows the errors of this
+// package: validator.ValidationError is a 422 with the field errors in the
+// errors member and errors wrapped with BadRequest are a 400.
+func NewErrorMapper() *ErrorMapper {
+	m := &ErrorMapper{}
+
+	m.MapFunc(func(err error) (Problem, bool) {
+		var validationErr validator.ValidationError
+		if !errors.As(err, &validationErr) {
+			return Problem{}, false
+		}
+		return validationProblem(validationErr), true
+	})
+
+	m.MapFunc(func(err error) (Problem, bool) {
+		var badRequest *badRequestError
+		if !errors.As(err, &badRequest) {
+			return Problem{}, false
+		}
+		return NewProblem(http.StatusBadRequest, CodeBadRequest, badRequest.Error()), true
+	})
+
+	return m
+}
+
+// Map reports errors matching target, as determined by errors.Is, with the
+// status and code. An empty detail is replaced by the message of the error.
+func (m *ErrorMapper) Map(target error, status int, code, detail string) *ErrorMapper {
+	return m.MapFunc(func(err error) (Problem, bool) {
+		if !errors.Is(err, target) {
+			return Problem{}, false
+		}
+
+		if detail == "" {
+			return NewProblem(status, code, err.Error()), true
+		}
+		return NewProblem(status, code, detail), true
+	})
+}
+
+// MapFunc adds a mapping for errors which can't be matched with errors.Is,
+// fn reports whether it handled the error.
+func (m *ErrorMapper) MapFunc(fn func(err error) (Problem, bool)) *ErrorMapper {
+	m.mappings = append(m.mappings, fn)
+	return m
+}
+
+// WriteError sends the problem err maps to, or a 500 when it is not mapped.
+func (m *ErrorMapper) WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	for _, mapping := range m.mappings {
+		if p, ok := mapping(err); ok {
+			WriteProblem(w, r, p)
+			return
+		}
+	}
+
+	ErrServer(w, r, err)
+}
+
+type badRequestError struct {
+	err error
+}
+
+func (e *badRequestError) Error() string { return e.err.Error() }
+func (e *badRequestError) Unwrap() error { return e.err }
+
+// BadRequest marks err as caused by a malformed request, such as an invalid
+// header, so that it is reported as a 400 instead of a 500.
+func BadRequest(err error) error {
+	return &badRequestError{err: err}
+}
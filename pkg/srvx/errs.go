@@ -1,35 +1,93 @@
 package srvx
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-)
 
-func errorResponse(w http.ResponseWriter, r *http.Request, status int, message any) {
-	env := Envelope{"error": message}
-	Logger(r.Context()).Error("error response", "status", status, "message", message)
+	"github.com/zbsss/greenlight/pkg/validator"
+)
 
-	err := WriteJSON(w, status, env, nil)
-	if err != nil {
-		LogErr(r, err)
-		w.WriteHeader(http.StatusInternalServerError)
-	}
+func errorResponse(w http.ResponseWriter, r *http.Request, status int, code, detail string) {
+	WriteProblem(w, r, NewProblem(status, code, detail))
 }
 
 func ErrServer(w http.ResponseWriter, r *http.Request, err error) {
 	LogErr(r, err)
-	errorResponse(w, r, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+
+	// The cause is only logged, it may contain details which must not
+	// leak to clients.
+	detail := "the server encountered a problem and could not process your request"
+	errorResponse(w, r, http.StatusInternalServerError, CodeInternal, detail)
 }
 
 func ErrNotFound(w http.ResponseWriter, r *http.Request) {
-	errorResponse(w, r, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+	detail := "the requested resource could not be found"
+	errorResponse(w, r, http.StatusNotFound, CodeNotFound, detail)
 }
 
 func ErrMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
-	message := fmt.Sprintf("the %s method is not supported for this resource", r.Method)
-	errorResponse(w, r, http.StatusMethodNotAllowed, message)
+	detail := fmt.Sprintf("the %s method is not supported for this resource", r.Method)
+	errorResponse(w, r, http.StatusMethodNotAllowed, CodeMethodNotAllowed, detail)
+}
+
+func ErrEditConflict(w http.ResponseWriter, r *http.Request) {
+	detail := "unable to update the record due to an edit conflict, please try again"
+	errorResponse(w, r, http.StatusConflict, CodeEditConflict, detail)
+}
+
+func ErrInvalidCredentials(w http.ResponseWriter, r *http.Request) {
+	detail := "invalid authentication credentials"
+	errorResponse(w, r, http.StatusUnauthorized, CodeInvalidCredentials, detail)
+}
+
+func ErrInvalidAuthenticationToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+
+	detail := "invalid or missing authentication token"
+	errorResponse(w, r, http.StatusUnauthorized, CodeInvalidToken, detail)
+}
+
+func ErrAuthenticationRequired(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+
+	detail := "you must be authenticated to access this resource"
+	errorResponse(w, r, http.StatusUnauthorized, CodeAuthenticationRequired, detail)
+}
+
+func ErrInactiveAccount(w http.ResponseWriter, r *http.Request) {
+	detail := "your user account must be activated to access this resource"
+	errorResponse(w, r, http.StatusForbidden, CodeInactiveAccount, detail)
+}
+
+func ErrForbidden(w http.ResponseWriter, r *http.Request) {
+	detail := "your user account doesn't have the necessary permissions to access this resource"
+	errorResponse(w, r, http.StatusForbidden, CodeForbidden, detail)
+}
+
+func ErrRateLimitExceeded(w http.ResponseWriter, r *http.Request) {
+	detail := "rate limit exceeded"
+	errorResponse(w, r, http.StatusTooManyRequests, CodeRateLimitExceeded, detail)
 }
 
 func ErrBadRequest(w http.ResponseWriter, r *http.Request, err error) {
-	errorResponse(w, r, http.StatusBadRequest, err)
+	// A request which is well-formed but fails validation is reported as
+	// unprocessable, with the field errors in the errors extension member.
+	var validationErr validator.ValidationError
+	if errors.As(err, &validationErr) {
+		ErrFailedValidation(w, r, validationErr)
+		return
+	}
+
+	errorResponse(w, r, http.StatusBadRequest, CodeBadRequest, err.Error())
+}
+
+func ErrFailedValidation(w http.ResponseWriter, r *http.Request, err validator.ValidationError) {
+	WriteProblem(w, r, validationProblem(err))
+}
+
+func validationProblem(err validator.ValidationError) Problem {
+	p := NewProblem(http.StatusUnprocessableEntity, CodeValidationFailed, "one or more fields are invalid")
+	p.Errors = err.Errors
+	return p
 }
@@ -0,0 +1,94 @@
+package srvx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+func TestErrorResponses(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tests := []struct {
+		name        string
+		write       func(w http.ResponseWriter, r *http.Request)
+		wantProblem Problem
+	}{
+		{
+			name: "validation error",
+			write: func(w http.ResponseWriter, r *http.Request) {
+				v := validator.New()
+				v.AddError("title", "must be provided")
+				ErrBadRequest(w, r, v.OK())
+			},
+			wantProblem: Problem{
+				Type:     "urn:problem-type:validation_failed",
+				Title:    "Unprocessable Entity",
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   "one or more fields are invalid",
+				Instance: traceID,
+				Code:     CodeValidationFailed,
+				Errors:   map[string]string{"title": "must be provided"},
+			},
+		},
+		{
+			name: "bad request",
+			write: func(w http.ResponseWriter, r *http.Request) {
+				ErrBadRequest(w, r, errors.New("body must not be empty"))
+			},
+			wantProblem: Problem{
+				Type:     "urn:problem-type:bad_request",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "body must not be empty",
+				Instance: traceID,
+				Code:     CodeBadRequest,
+			},
+		},
+		{
+			name: "server error hides the cause",
+			write: func(w http.ResponseWriter, r *http.Request) {
+				ErrServer(w, r, errors.New("connection refused"))
+			},
+			wantProblem: Problem{
+				Type:     "urn:problem-type:internal_error",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Detail:   "the server encountered a problem and could not process your request",
+				Instance: traceID,
+				Code:     CodeInternal,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), traceIDKey, traceID)
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/v1/movies", http.NoBody)
+			w := httptest.NewRecorder()
+
+			tt.write(w, r)
+
+			if w.Code != tt.wantProblem.Status {
+				t.Errorf("expected status %d; got %d", tt.wantProblem.Status, w.Code)
+			}
+			if got := w.Header().Get("Content-Type"); got != ProblemContentType {
+				t.Errorf("expected Content-Type %q; got %q", ProblemContentType, got)
+			}
+
+			var got Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantProblem, got); diff != "" {
+				t.Errorf("unexpected problem (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
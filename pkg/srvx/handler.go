@@ -0,0 +1,60 @@
+package srvx
+
+import (
+	"context"
+	"net/http"
+)
+
+// NoBody is the input of JSON handlers for requests without a body.
+type NoBody struct{}
+
+// Response is returned by JSON handlers which need to set the status code or
+// headers of the response. Handlers returning any other type respond with
+// 200 OK.
+type Response[T any] struct {
+	Status int
+	Header http.Header
+	Body   T
+}
+
+func (r Response[T]) response() (int, http.Header, any) {
+	if r.Status == 0 {
+		return http.StatusOK, r.Header, r.Body
+	}
+	return r.Status, r.Header, r.Body
+}
+
+type responder interface {
+	response() (int, http.Header, any)
+}
+
+// JSON adapts fn to an http.HandlerFunc. The request body is decoded into In,
+// unless In is NoBody, and the value returned by fn is sent as JSON. Errors
+// are sent as problems, as mapped by errs.
+func JSON[In, Out any](errs *ErrorMapper, fn func(ctx context.Context, in In) (Out, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var in In
+		if _, ok := any(in).(NoBody); !ok {
+			if err := ReadJSON(w, r, &in); err != nil {
+				ErrBadRequest(w, r, err)
+				return
+			}
+		}
+
+		out, err := fn(r.Context(), in)
+		if err != nil {
+			errs.WriteError(w, r, err)
+			return
+		}
+
+		status, headers, body := http.StatusOK, http.Header(nil), any(out)
+		if resp, ok := body.(responder); ok {
+			status, headers, body = resp.response()
+		}
+
+		if err := writeJSON(w, status, body, headers); err != nil {
+			ErrServer(w, r, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package srvx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zbsss/greenlight/pkg/validator"
+)
+
+var errWidgetNotFound = errors.New("widget not found")
+
+type widgetInput struct {
+	Name string `json:"name"`
+}
+
+func TestJSON(t *testing.T) {
+	errs := NewErrorMapper().
+		Map(errWidgetNotFound, http.StatusNotFound, CodeNotFound, "")
+
+	create := JSON(errs, func(_ context.Context, in widgetInput) (Response[Envelope], error) {
+		switch in.Name {
+		case "":
+			v := validator.New()
+			v.AddError("name", "must be provided")
+			return Response[Envelope]{}, v.OK()
+		case "bad header":
+			return Response[Envelope]{}, BadRequest(errors.New("the If-Match header must be quoted"))
+		case "missing":
+			return Response[Envelope]{}, fmt.Errorf("loading widget: %w", errWidgetNotFound)
+		case "broken":
+			return Response[Envelope]{}, errors.New("database is down")
+		}
+
+		headers := make(http.Header)
+		headers.Set("Location", "/widgets/1")
+		return Response[Envelope]{Status: http.StatusCreated, Header: headers, Body: Envelope{"name": in.Name}}, nil
+	})
+
+	get := JSON(errs, func(_ context.Context, _ NoBody) (Envelope, error) {
+		return Envelope{"name": "gear"}, nil
+	})
+
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		body       string
+		wantStatus int
+		wantCode   string
+		wantHeader string
+	}{
+		{
+			name:       "created",
+			handler:    create,
+			body:       `{"name": "gear"}`,
+			wantStatus: http.StatusCreated,
+			wantHeader: "/widgets/1",
+		},
+		{
+			name:       "no body",
+			handler:    get,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "malformed body",
+			handler:    create,
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeBadRequest,
+		},
+		{
+			name:       "validation error",
+			handler:    create,
+			body:       `{"name": ""}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   CodeValidationFailed,
+		},
+		{
+			name:       "bad request",
+			handler:    create,
+			body:       `{"name": "bad header"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeBadRequest,
+		},
+		{
+			name:       "mapped error",
+			handler:    create,
+			body:       `{"name": "missing"}`,
+			wantStatus: http.StatusNotFound,
+			wantCode:   CodeNotFound,
+		},
+		{
+			name:       "unmapped error",
+			handler:    create,
+			body:       `{"name": "broken"}`,
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/widgets", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			tt.handler(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d; got %d: %s", tt.wantStatus, w.Code, w.Body)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantHeader {
+				t.Errorf("expected Location %q; got %q", tt.wantHeader, got)
+			}
+
+			if tt.wantCode == "" {
+				if got := w.Header().Get("Content-Type"); got != "application/json" {
+					t.Errorf("expected Content-Type application/json; got %q", got)
+				}
+				return
+			}
+
+			var p Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+				t.Fatal(err)
+			}
+			if p.Code != tt.wantCode {
+				t.Errorf("expected code %q; got %q", tt.wantCode, p.Code)
+			}
+		})
+	}
+}
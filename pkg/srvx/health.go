@@ -0,0 +1,134 @@
+package srvx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+var errShuttingDown = errors.New("server is shutting down")
+
+// HealthCheck reports whether a dependency is usable. It should return
+// quickly, the context is cancelled after a few seconds.
+type HealthCheck func(ctx context.Context) error
+
+// Health is a registry of named health checks served as /livez and /readyz.
+// Liveness checks should only fail when restarting the process would help,
+// readiness checks fail whenever the server cannot serve traffic.
+type Health struct {
+	mu        sync.RWMutex
+	liveness  map[string]HealthCheck
+	readiness map[string]HealthCheck
+
+	shuttingDown atomic.Bool
+}
+
+func NewHealth() *Health {
+	h := &Health{
+		liveness:  make(map[string]HealthCheck),
+		readiness: make(map[string]HealthCheck),
+	}
+
+	h.AddReadinessCheck("shutdown", func(context.Context) error {
+		if h.shuttingDown.Load() {
+			return errShuttingDown
+		}
+		return nil
+	})
+
+	return h
+}
+
+func (h *Health) AddLivenessCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.liveness[name] = check
+}
+
+func (h *Health) AddReadinessCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.readiness[name] = check
+}
+
+// LivenessHandler serves the result of the liveness checks.
+func (h *Health) LivenessHandler() http.Handler {
+	return h.handler(func() map[string]HealthCheck { return h.liveness })
+}
+
+// ReadinessHandler serves the result of the readiness checks. Readiness
+// fails as soon as the server starts shutting down, so that no new traffic
+// is routed to it while in-flight requests drain.
+func (h *Health) ReadinessHandler() http.Handler {
+	return h.handler(func() map[string]HealthCheck { return h.readiness })
+}
+
+func (h *Health) shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+type checkResult struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (h *Health) handler(checks func() map[string]HealthCheck) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+
+		h.mu.RLock()
+		results := runChecks(ctx, checks())
+		h.mu.RUnlock()
+
+		status, code := "ok", http.StatusOK
+		for _, result := range results {
+			if result.Status != "ok" {
+				status, code = "fail", http.StatusServiceUnavailable
+			}
+		}
+
+		env := Envelope{"status": status, "checks": results}
+		if err := WriteJSON(w, code, env, nil); err != nil {
+			LogErr(r, err)
+		}
+	})
+}
+
+// runChecks runs the checks concurrently so that one slow dependency does
+// not add up with the others.
+func runChecks(ctx context.Context, checks map[string]HealthCheck) map[string]checkResult {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]checkResult, len(checks))
+	)
+
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			start := time.Now()
+			err := check(ctx)
+			result := checkResult{Status: "ok", Duration: time.Since(start).String()}
+			if err != nil {
+				result.Status = "fail"
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,63 @@
+package srvx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealth(t *testing.T) {
+	var dbErr error
+	h := NewHealth()
+	h.AddReadinessCheck("postgres", func(context.Context) error { return dbErr })
+
+	get := func(handler http.Handler) (int, map[string]checkResult) {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var body struct {
+			Status string                 `json:"status"`
+			Checks map[string]checkResult `json:"checks"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if (w.Code == http.StatusOK) != (body.Status == "ok") {
+			t.Errorf("status %d does not match body status %q", w.Code, body.Status)
+		}
+		return w.Code, body.Checks
+	}
+
+	if code, checks := get(h.ReadinessHandler()); code != http.StatusOK || checks["postgres"].Status != "ok" {
+		t.Errorf("expected ready, got %d %+v", code, checks)
+	}
+
+	dbErr = errors.New("connection refused")
+	code, checks := get(h.ReadinessHandler())
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d when postgres is down, got %d", http.StatusServiceUnavailable, code)
+	}
+	if checks["postgres"].Error != "connection refused" || checks["shutdown"].Status != "ok" {
+		t.Errorf("unexpected check results %+v", checks)
+	}
+
+	// Dependencies do not affect liveness.
+	if code, _ := get(h.LivenessHandler()); code != http.StatusOK {
+		t.Errorf("expected live, got %d", code)
+	}
+
+	dbErr = nil
+	h.shutdown()
+	code, checks = get(h.ReadinessHandler())
+	if code != http.StatusServiceUnavailable || checks["shutdown"].Status != "fail" {
+		t.Errorf("expected readiness to fail during shutdown, got %d %+v", code, checks)
+	}
+	if code, _ := get(h.LivenessHandler()); code != http.StatusOK {
+		t.Errorf("expected live during shutdown, got %d", code)
+	}
+}
@@ -12,6 +12,12 @@ import (
 type Envelope map[string]any
 
 func WriteJSON(w http.ResponseWriter, status int, data Envelope, headers http.Header) error {
+	return writeJSON(w, status, data, headers)
+}
+
+// writeJSON sends data as JSON. The Content-Type is application/json unless
+// headers sets another one.
+func writeJSON(w http.ResponseWriter, status int, data any, headers http.Header) error {
 	js, err := json.MarshalIndent(data, "", "\t")
 	if err != nil {
 		return err
@@ -22,7 +28,9 @@ func WriteJSON(w http.ResponseWriter, status int, data Envelope, headers http.He
 		w.Header()[key] = value
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	if headers.Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
 	w.WriteHeader(status)
 
 	_, err = w.Write(js)
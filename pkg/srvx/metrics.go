@@ -0,0 +1,123 @@
+package srvx
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests which were answered before reaching the
+// router, e.g. by the rate limiter, or which matched no route.
+const unmatchedRoute = "unmatched"
+
+// Metrics holds the Prometheus collectors of a server. Applications can
+// register their own collectors with Registry.
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	inFlight prometheus.Gauge
+	duration *prometheus.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Number of HTTP requests served.",
+		}, []string{"route", "method", "status"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently being served.",
+		}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Time taken to serve HTTP requests.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.inFlight,
+		m.duration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
+
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler serves the metrics in the Prometheus text exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// instrument records every request. The route is only known once the router
+// has matched the request, so recordRoute has to run right before it.
+func (m *Metrics) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		m.inFlight.Inc()
+		defer m.inFlight.Dec()
+
+		r, route := withRoute(r)
+		wrapped := &wrappedWriter{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+
+		next.ServeHTTP(wrapped, r)
+
+		labels := prometheus.Labels{
+			"route":  *route,
+			"method": r.Method,
+			"status": strconv.Itoa(wrapped.statusCode),
+		}
+		m.requests.With(labels).Inc()
+		m.duration.With(labels).Observe(time.Since(start).Seconds())
+	})
+}
+
+// withRoute makes room in the request context for the route recorded by
+// recordRoute, unless an earlier middleware already did.
+func withRoute(r *http.Request) (*http.Request, *string) {
+	if route, ok := r.Context().Value(routeKey).(*string); ok {
+		return r, route
+	}
+
+	route := unmatchedRoute
+	return r.WithContext(context.WithValue(r.Context(), routeKey, &route)), &route
+}
+
+// recordRoute reports the pattern matched by the router back to instrument
+// and traceRequest.
+// http.ServeMux sets the pattern on the request it was given, so it can be
+// read once the handler returns.
+func recordRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+
+		route, ok := r.Context().Value(routeKey).(*string)
+		if !ok || r.Pattern == "" {
+			return
+		}
+
+		// Patterns may start with a method, which has a label of its own.
+		pattern := r.Pattern
+		if _, path, ok := strings.Cut(pattern, " "); ok {
+			pattern = path
+		}
+		*route = pattern
+	})
+}
@@ -0,0 +1,72 @@
+package srvx
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestMetrics(t *testing.T) {
+	metrics := NewMetrics()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/things/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	srv, err := NewServer(Config{Metrics: metrics}, mux, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, target := range []string{"/v1/things/1", "/v1/things/2", "/nowhere"} {
+		srv.Handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	families, err := metrics.Registry().Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != "http_requests_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			counts[labelString(m)] = m.GetCounter().GetValue()
+		}
+	}
+
+	want := map[string]float64{
+		"method=GET,route=/v1/things/{id},status=204": 2,
+		"method=GET,route=unmatched,status=404":       1,
+	}
+	for labels, value := range want {
+		if counts[labels] != value {
+			t.Errorf("expected http_requests_total{%s} to be %v, got %v (all: %v)", labels, value, counts[labels], counts)
+		}
+	}
+
+	// The exposition endpoint includes the runtime collectors too.
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, name := range []string{"http_request_duration_seconds_bucket", "http_requests_in_flight", "go_goroutines"} {
+		if !strings.Contains(w.Body.String(), name) {
+			t.Errorf("expected /metrics to contain %s", name)
+		}
+	}
+}
+
+func labelString(m *dto.Metric) string {
+	pairs := make([]string, 0, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		pairs = append(pairs, l.GetName()+"="+l.GetValue())
+	}
+	return strings.Join(pairs, ",")
+}
@@ -1,13 +1,9 @@
 package srvx
 
 import (
-	"context"
 	"fmt"
-	"log/slog"
 	"net/http"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 type wrappedWriter struct {
@@ -20,6 +16,12 @@ func (w *wrappedWriter) WriteHeader(statusCode int) {
 	w.statusCode = statusCode
 }
 
+// Unwrap lets http.ResponseController reach the Flusher and the deadlines
+// of the underlying writer, which streaming handlers need.
+func (w *wrappedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func logResponseCode(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -39,17 +41,14 @@ func logResponseCode(next http.Handler) http.Handler {
 	})
 }
 
+// secureHeaders sets the security headers for JSON API responses, which are
+// never meant to be rendered or framed by a browser.
 func secureHeaders(next http.Handler) http.Handler {
 	middleware := func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		w.Header().Set("Access-Control-Allow-Credentials", "true")
-
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; frame-ancestors 'none'")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("X-Frame-Options", "deny")
+		w.Header().Set("Referrer-Policy", "no-referrer")
 
 		next.ServeHTTP(w, r)
 	}
@@ -61,6 +60,12 @@ func recoverPanic(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if err := recover(); err != nil {
+				// Handlers abort responses which were already started,
+				// the server closes the connection without a problem.
+				if err == http.ErrAbortHandler {
+					panic(err)
+				}
+
 				w.Header().Set("Connection", "close")
 
 				ErrServer(w, r, fmt.Errorf("%+v", err))
@@ -70,38 +75,3 @@ func recoverPanic(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
-
-const traceIDHeader = "X-Trace-ID"
-
-func traceRequest(log *slog.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			var traceID string
-
-			// Check if the incoming request has a X-Trace-ID header
-			incomingTraceID := r.Header.Get(traceIDHeader)
-			if incomingTraceID != "" {
-				traceID = incomingTraceID
-			} else {
-				// Generate a new trace ID if not present in the request
-				traceID = uuid.New().String()
-			}
-
-			// Set the traceID in the response header
-			w.Header().Set(traceIDHeader, traceID)
-			ctx := context.WithValue(r.Context(), traceIDKey, traceID)
-
-			// Create a fresh logger with request details
-			requestLog := log.With(
-				"traceID", traceID,
-				"ip", r.RemoteAddr,
-				"proto", r.Proto,
-				"method", r.Method,
-				"uri", r.URL.RequestURI(),
-			)
-			ctx = context.WithValue(ctx, requestLoggerKey, requestLog)
-
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
-	}
-}
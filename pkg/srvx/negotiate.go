@@ -0,0 +1,67 @@
+package srvx
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Negotiate picks the media type of the response from the offers, following
+// the Accept header of the request. Offers are preferred in the order they
+// are given when the client rates them equally, and the first offer is used
+// when there is no Accept header. It reports false when the client accepts
+// none of the offers.
+func Negotiate(r *http.Request, offers ...string) (string, bool) {
+	accept := r.Header.Values("Accept")
+	if len(accept) == 0 {
+		return offers[0], true
+	}
+
+	best, bestQ := "", 0.0
+	for _, offer := range offers {
+		if q := acceptQuality(accept, offer); q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best, bestQ > 0
+}
+
+// acceptQuality returns the quality the Accept header gives the offer, from
+// the most specific media range matching it.
+func acceptQuality(accept []string, offer string) float64 {
+	offerType, _, _ := strings.Cut(offer, "/")
+
+	q, specificity := 0.0, -1
+	for _, header := range accept {
+		for mediaRange := range strings.SplitSeq(header, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+			if err != nil {
+				continue
+			}
+
+			var s int
+			switch {
+			case mediaType == offer:
+				s = 2
+			case mediaType == offerType+"/*":
+				s = 1
+			case mediaType == "*/*":
+				s = 0
+			default:
+				continue
+			}
+			if s <= specificity {
+				continue
+			}
+
+			specificity, q = s, 1
+			if v, ok := params["q"]; ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+	}
+	return q
+}
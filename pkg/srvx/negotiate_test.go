@@ -0,0 +1,57 @@
+package srvx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{"application/json", "text/csv", "application/x-ndjson"}
+
+	tests := []struct {
+		name   string
+		accept []string
+		want   string
+		wantOK bool
+	}{
+		{name: "no accept header", want: "application/json", wantOK: true},
+		{name: "exact match", accept: []string{"text/csv"}, want: "text/csv", wantOK: true},
+		{name: "any", accept: []string{"*/*"}, want: "application/json", wantOK: true},
+		{name: "subtype wildcard", accept: []string{"text/*"}, want: "text/csv", wantOK: true},
+		{name: "quality", accept: []string{"application/json;q=0.5, application/x-ndjson"}, want: "application/x-ndjson", wantOK: true},
+		{name: "several headers", accept: []string{"text/html", "text/csv;q=0.9"}, want: "text/csv", wantOK: true},
+		{name: "specific range wins", accept: []string{"*/*;q=0.1, text/csv;q=0"}, want: "application/json", wantOK: true},
+		{name: "not acceptable", accept: []string{"text/html"}, wantOK: false},
+		{name: "refused", accept: []string{"application/json;q=0"}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, v := range tt.accept {
+				r.Header.Add("Accept", v)
+			}
+
+			got, ok := Negotiate(r, offers...)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("expected %q %t; got %q %t", tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+}
+
+func TestWrappedWriterFlush(t *testing.T) {
+	h := logResponseCode(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("expected the wrapped writer to flush; got %v", err)
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if !rec.Flushed {
+		t.Error("expected the response to be flushed")
+	}
+}
@@ -0,0 +1,78 @@
+package srvx
+
+import (
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details.
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeBase is prefixed to the error code to build the type URI of a
+// problem.
+var ProblemTypeBase = "urn:problem-type:"
+
+// Error codes are stable identifiers of the problems returned by the API,
+// clients should branch on them instead of the human readable title or
+// detail.
+const (
+	CodeBadRequest             = "bad_request"
+	CodeValidationFailed       = "validation_failed"
+	CodeNotFound               = "not_found"
+	CodeUnsupportedMediaType   = "unsupported_media_type"
+	CodeNotAcceptable          = "not_acceptable"
+	CodeMethodNotAllowed       = "method_not_allowed"
+	CodeEditConflict           = "edit_conflict"
+	CodeConflict               = "conflict"
+	CodeInvalidCredentials     = "invalid_credentials"
+	CodeInvalidToken           = "invalid_token"
+	CodeAuthenticationRequired = "authentication_required"
+	CodeInactiveAccount        = "inactive_account"
+	CodeForbidden              = "forbidden"
+	CodeRateLimitExceeded      = "rate_limit_exceeded"
+	CodeInternal               = "internal_error"
+)
+
+// Problem is an RFC 9457 problem details object. Code and Errors are
+// extension members.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	// Errors maps the invalid fields to what is wrong with them.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// NewProblem creates a problem with the type and title derived from the code
+// and status.
+func NewProblem(status int, code, detail string) Problem {
+	return Problem{
+		Type:   ProblemTypeBase + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// WriteProblem sends the problem as an application/problem+json response.
+// The instance is set to the trace ID of the request, so that a problem
+// reported by a client can be found in the logs and traces.
+func WriteProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	if p.Instance == "" {
+		p.Instance = TraceID(r.Context())
+	}
+
+	Logger(r.Context()).Error("error response", "status", p.Status, "code", p.Code, "detail", p.Detail)
+
+	h := http.Header{}
+	h.Set("Content-Type", ProblemContentType)
+
+	err := writeJSON(w, p.Status, p, h)
+	if err != nil {
+		LogErr(r, err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
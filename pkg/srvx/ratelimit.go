@@ -0,0 +1,213 @@
+package srvx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// clientIdleTimeout is how long the in-memory limiter remembers a client
+	// which has stopped sending requests.
+	clientIdleTimeout = 3 * time.Minute
+)
+
+// RateLimitConfig configures the token bucket every client gets. Each
+// request takes a token, tokens are refilled at RPS per second up to Burst.
+type RateLimitConfig struct {
+	Enabled bool
+	RPS     float64
+	Burst   int
+	// Limiter keeps track of the buckets. Defaults to an in-memory limiter,
+	// which is only correct when a single instance serves the API.
+	Limiter RateLimiter
+}
+
+func (c RateLimitConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RPS <= 0 {
+		return errors.New("rate limit: RPS must be greater than zero")
+	}
+	if c.Burst < 1 {
+		return errors.New("rate limit: burst must be at least 1")
+	}
+	return nil
+}
+
+// RateLimiter takes a token from the bucket of the client identified by key.
+// Implementations backed by a shared store, e.g. Postgres, allow the limit to
+// be enforced across several instances of the server.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string) (RateLimitDecision, error)
+}
+
+// RateLimitDecision is the state of a client's bucket after a request.
+type RateLimitDecision struct {
+	Allowed bool
+	// Limit is the size of the bucket.
+	Limit int
+	// Remaining is the number of requests which can be made right away.
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the next request is allowed, only set
+	// for denied requests.
+	RetryAfter time.Duration
+}
+
+// Subject is implemented by users which should get their own rate limit
+// instead of sharing the limit of the IP address they connect from.
+type Subject interface {
+	Subject() string
+}
+
+// rateLimit rejects requests from clients which have used up their bucket.
+func rateLimit(limiter RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if allowRequest(w, r, limiter, clientKey(r)) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// allowRequest takes a token from the bucket identified by key and sets the
+// RateLimit headers. It writes the error response and returns false when the
+// bucket is empty.
+func allowRequest(w http.ResponseWriter, r *http.Request, limiter RateLimiter, key string) bool {
+	decision, err := limiter.Allow(r.Context(), key)
+	if err != nil {
+		// A broken shared backend should not take the API down with it.
+		LogErr(r, err)
+		return true
+	}
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.Reset)))
+
+	if !decision.Allowed {
+		w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+		ErrRateLimitExceeded(w, r)
+		return false
+	}
+
+	return true
+}
+
+// clientKey identifies the client for rate limiting, by user when the request
+// is authenticated and by IP address otherwise.
+func clientKey(r *http.Request) string {
+	if user, ok := User[Subject](r.Context()); ok {
+		return "user:" + user.Subject()
+	}
+	return ipKey(r)
+}
+
+func ipKey(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return "ip:" + ip
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// TokenBucket is the state of a single client's bucket. It is exported so
+// that other RateLimiter implementations can store it and share the maths.
+type TokenBucket struct {
+	Tokens float64
+	Last   time.Time
+}
+
+// Take refills the bucket for the time passed since it was last used and
+// then tries to take a token from it.
+func (b *TokenBucket) Take(now time.Time, rps float64, burst int) RateLimitDecision {
+	if b.Last.IsZero() {
+		b.Tokens = float64(burst)
+	} else if elapsed := now.Sub(b.Last).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(float64(burst), b.Tokens+elapsed*rps)
+	}
+	b.Last = now
+
+	decision := RateLimitDecision{Limit: burst}
+
+	if b.Tokens >= 1 {
+		b.Tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = secondsToDuration((1 - b.Tokens) / rps)
+	}
+
+	decision.Remaining = int(b.Tokens)
+	decision.Reset = secondsToDuration((float64(burst) - b.Tokens) / rps)
+
+	return decision
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// MemoryRateLimiter keeps the buckets in memory. Clients which have been idle
+// for a while are forgotten, a returning client starts with a full bucket.
+type MemoryRateLimiter struct {
+	rps   float64
+	burst int
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*TokenBucket
+	lastSweep time.Time
+}
+
+func NewMemoryRateLimiter(rps float64, burst int) *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		rps:     rps,
+		burst:   burst,
+		now:     time.Now,
+		buckets: make(map[string]*TokenBucket),
+	}
+}
+
+func (l *MemoryRateLimiter) Allow(_ context.Context, key string) (RateLimitDecision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.evictIdle(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &TokenBucket{}
+		l.buckets[key] = bucket
+	}
+
+	return bucket.Take(now, l.rps, l.burst), nil
+}
+
+// evictIdle removes idle clients. It runs at most once per idle timeout, so
+// the cost of going over the map is spread across many requests.
+func (l *MemoryRateLimiter) evictIdle(now time.Time) {
+	if now.Sub(l.lastSweep) < clientIdleTimeout {
+		return
+	}
+	l.lastSweep = now
+
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.Last) > clientIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
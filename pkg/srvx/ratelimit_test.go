@@ -0,0 +1,145 @@
+package srvx
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var b TokenBucket
+
+	steps := []struct {
+		name          string
+		at            time.Duration
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		{name: "first request gets a full bucket", at: 0, wantAllowed: true, wantRemaining: 2},
+		{name: "burst", at: 0, wantAllowed: true, wantRemaining: 1},
+		{name: "last token", at: 0, wantAllowed: true, wantRemaining: 0},
+		{name: "empty bucket", at: 0, wantAllowed: false, wantRemaining: 0, wantRetry: 500 * time.Millisecond},
+		{name: "refilled", at: 500 * time.Millisecond, wantAllowed: true, wantRemaining: 0},
+		{name: "refill is capped at burst", at: time.Hour, wantAllowed: true, wantRemaining: 2},
+	}
+
+	for _, step := range steps {
+		d := b.Take(start.Add(step.at), 2, 3)
+
+		if d.Allowed != step.wantAllowed {
+			t.Errorf("%s: expected allowed %v, got %v", step.name, step.wantAllowed, d.Allowed)
+		}
+		if d.Remaining != step.wantRemaining {
+			t.Errorf("%s: expected %d remaining, got %d", step.name, step.wantRemaining, d.Remaining)
+		}
+		if d.RetryAfter != step.wantRetry {
+			t.Errorf("%s: expected retry after %s, got %s", step.name, step.wantRetry, d.RetryAfter)
+		}
+		if d.Limit != 3 {
+			t.Errorf("%s: expected limit 3, got %d", step.name, d.Limit)
+		}
+	}
+}
+
+func TestMemoryRateLimiterEvictsIdleClients(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewMemoryRateLimiter(1, 1)
+	l.now = func() time.Time { return now }
+
+	if _, err := l.Allow(context.Background(), "ip:192.0.2.1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.buckets) != 1 {
+		t.Fatalf("expected 1 client, got %d", len(l.buckets))
+	}
+
+	now = now.Add(2 * clientIdleTimeout)
+	if _, err := l.Allow(context.Background(), "ip:192.0.2.2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := l.buckets["ip:192.0.2.1"]; ok {
+		t.Error("expected idle client to be evicted")
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("expected 1 client, got %d", len(l.buckets))
+	}
+}
+
+type testSubject string
+
+func (s testSubject) Subject() string {
+	return string(s)
+}
+
+func TestRateLimit(t *testing.T) {
+	h := rateLimit(NewMemoryRateLimiter(1, 2))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	send := func(user Subject) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		if user != nil {
+			r = r.WithContext(context.WithValue(r.Context(), userKey, user))
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	for i, wantRemaining := range []string{"1", "0"} {
+		w := send(nil)
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("request %d: expected status %d, got %d", i, http.StatusNoContent, w.Code)
+		}
+		if got := w.Header().Get("RateLimit-Remaining"); got != wantRemaining {
+			t.Errorf("request %d: expected RateLimit-Remaining %s, got %s", i, wantRemaining, got)
+		}
+		if got := w.Header().Get("RateLimit-Limit"); got != "2" {
+			t.Errorf("request %d: expected RateLimit-Limit 2, got %s", i, got)
+		}
+	}
+
+	w := send(nil)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("expected Retry-After 1, got %q", got)
+	}
+
+	// Authenticated users do not share the bucket of their IP address.
+	if w := send(testSubject("42")); w.Code != http.StatusNoContent {
+		t.Errorf("expected authenticated request to be allowed, got %d", w.Code)
+	}
+}
+
+func TestNewServerRejectsInvalidRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     RateLimitConfig
+		wantErr bool
+	}{
+		{name: "disabled", cfg: RateLimitConfig{}},
+		{name: "valid", cfg: RateLimitConfig{Enabled: true, RPS: 2, Burst: 4}},
+		{name: "zero rps", cfg: RateLimitConfig{Enabled: true, Burst: 4}, wantErr: true},
+		{name: "negative rps", cfg: RateLimitConfig{Enabled: true, RPS: -1, Burst: 4}, wantErr: true},
+		{name: "zero burst", cfg: RateLimitConfig{Enabled: true, RPS: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewServer(Config{RateLimit: tt.cfg}, http.NotFoundHandler(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
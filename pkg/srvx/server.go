@@ -2,6 +2,7 @@ package srvx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/justinas/alice"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -19,21 +22,77 @@ const (
 
 type Config struct {
 	Port int
+	// AdminPort is where operational endpoints such as /metrics are served,
+	// away from the public API. The admin listener is disabled when it is 0.
+	AdminPort int
+	// Metrics instruments every request when it is set.
+	Metrics *Metrics
+	// Health is served as /livez and /readyz on the admin listener.
+	Health *Health
+	// TracerProvider creates the server spans, defaults to the global
+	// provider.
+	TracerProvider trace.TracerProvider
+	// Authenticator resolves bearer tokens. Requests are not authenticated
+	// when it is nil.
+	Authenticator Authenticator
+	RateLimit     RateLimitConfig
+	CORS          CORSConfig
 }
 
 type Server struct {
 	*http.Server
-	log *slog.Logger
+	admin  *http.Server
+	health *Health
+	log    *slog.Logger
 }
 
-func NewServer(cfg Config, handler http.Handler, log *slog.Logger) *Server {
+// NewServer wraps the handler in the common middleware. It returns an error
+// when the configuration is invalid.
+func NewServer(cfg Config, handler http.Handler, log *slog.Logger) (*Server, error) {
+	if err := cfg.RateLimit.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.CORS.validate(); err != nil {
+		return nil, err
+	}
+
+	tracerProvider := cfg.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+
 	// common middleware for all APIs
-	h := alice.New(
+	chain := alice.New()
+	if cfg.Metrics != nil {
+		chain = chain.Append(cfg.Metrics.instrument)
+	}
+	chain = chain.Append(
 		recoverPanic,
-		traceRequest(log),
+		traceRequest(log, tracerProvider),
 		logResponseCode,
 		secureHeaders,
-	).Then(handler)
+		// CORS comes before authentication so that preflight requests, which
+		// carry no credentials, and error responses get the CORS headers.
+		cors(cfg.CORS),
+	)
+	var limiter RateLimiter
+	if cfg.RateLimit.Enabled {
+		limiter = cfg.RateLimit.Limiter
+		if limiter == nil {
+			limiter = NewMemoryRateLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst)
+		}
+	}
+	if cfg.Authenticator != nil {
+		// Requests with a bad token are rejected here, so they take a token
+		// from the bucket of their IP address instead of the one below.
+		chain = chain.Append(authenticate(cfg.Authenticator, limiter))
+	}
+	// Rate limiting comes after authentication so that users are limited
+	// individually rather than by IP address.
+	if limiter != nil {
+		chain = chain.Append(rateLimit(limiter))
+	}
+	h := chain.Append(recordRoute).Then(handler)
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%d", cfg.Port),
@@ -44,27 +103,65 @@ func NewServer(cfg Config, handler http.Handler, log *slog.Logger) *Server {
 		ErrorLog:     slog.NewLogLogger(log.Handler(), slog.LevelError),
 	}
 
-	return &Server{srv, log}
+	var admin *http.Server
+	if cfg.AdminPort != 0 {
+		mux := http.NewServeMux()
+		if cfg.Metrics != nil {
+			mux.Handle("GET /metrics", cfg.Metrics.Handler())
+		}
+		if cfg.Health != nil {
+			mux.Handle("GET /livez", cfg.Health.LivenessHandler())
+			mux.Handle("GET /readyz", cfg.Health.ReadinessHandler())
+		}
+
+		admin = &http.Server{
+			Addr:         fmt.Sprintf(":%d", cfg.AdminPort),
+			Handler:      recoverPanic(mux),
+			IdleTimeout:  time.Minute,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+			ErrorLog:     slog.NewLogLogger(log.Handler(), slog.LevelError),
+		}
+	}
+
+	return &Server{srv, admin, cfg.Health, log}, nil
 }
 
 func (s *Server) ListenAndServe(ctx context.Context) error {
-	go func() {
-		if err := s.Server.ListenAndServe(); err != nil {
-			if err == http.ErrServerClosed {
-				s.log.Info("server shut down gracefully")
-			} else {
-				s.log.Error("server shut down unexpectedly", "error", err.Error())
-			}
-		}
-		s.log.Info("server shut down")
-	}()
+	go s.serve(s.Server, "server")
+	if s.admin != nil {
+		go s.serve(s.admin, "admin server")
+	}
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	if s.health != nil {
+		s.health.shutdown()
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
 	defer cancel()
 
-	return s.Shutdown(ctx)
+	// The admin server stays up until the API has drained so that it can
+	// still be scraped while requests finish.
+	err := s.Shutdown(ctx)
+	if s.admin != nil {
+		err = errors.Join(err, s.admin.Shutdown(ctx))
+	}
+	return err
+}
+
+func (s *Server) serve(srv *http.Server, name string) {
+	s.log.Info("listening", "server", name, "addr", srv.Addr)
+
+	if err := srv.ListenAndServe(); err != nil {
+		if err == http.ErrServerClosed {
+			s.log.Info(name + " shut down gracefully")
+		} else {
+			s.log.Error(name+" shut down unexpectedly", "error", err.Error())
+		}
+	}
+	s.log.Info(name + " shut down")
 }
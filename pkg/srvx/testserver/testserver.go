@@ -2,6 +2,7 @@ package testserver
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -23,8 +24,20 @@ func New(h http.Handler) *Server {
 }
 
 func (ts *Server) Get(t *testing.T, urlPath string) (status int, header http.Header, body string) {
-	//nolint: noctx
-	rs, err := ts.Client().Get(ts.URL + urlPath)
+	return ts.Do(t, http.MethodGet, urlPath, nil)
+}
+
+// Do sends a request with the given method and JSON body to the test server.
+func (ts *Server) Do(t *testing.T, method, urlPath string, reqBody io.Reader) (status int, header http.Header, body string) {
+	req, err := http.NewRequestWithContext(context.Background(), method, ts.URL+urlPath, reqBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rs, err := ts.Client().Do(req)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,200 @@
+package srvx
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// traceIDHeader carries the trace ID in responses for clients which
+	// predate W3C trace context. It is also accepted on requests without a
+	// traceparent header.
+	traceIDHeader = "X-Trace-ID"
+
+	tracerName = "github.com/zbsss/greenlight/pkg/srvx"
+)
+
+// propagator reads and writes the W3C traceparent, tracestate and baggage
+// headers.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// TraceID returns the trace ID of the request in the given context.
+func TraceID(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey).(string)
+	return traceID
+}
+
+// traceRequest starts a server span for every request, continuing the trace
+// of the caller when it sent a traceparent header, and stores a logger
+// tagged with the trace ID in the request context.
+func traceRequest(log *slog.Logger, tp trace.TracerProvider) func(http.Handler) http.Handler {
+	tracer := tp.Tracer(tracerName)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r, route := withRoute(r)
+
+			ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			if !trace.SpanContextFromContext(ctx).IsValid() {
+				ctx = legacyTraceParent(ctx, r.Header.Get(traceIDHeader))
+			}
+
+			ctx, span := tracer.Start(ctx, r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.URLPath(r.URL.Path),
+					semconv.ClientAddress(r.RemoteAddr),
+					semconv.UserAgentOriginal(r.UserAgent()),
+				),
+			)
+
+			traceID := span.SpanContext().TraceID().String()
+			if !span.SpanContext().IsValid() {
+				// Tracing is disabled, the ID still ties log lines together.
+				traceID = newTraceID().String()
+			}
+
+			// Respond with the trace context so that clients can look up
+			// the trace of their request.
+			propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+			w.Header().Set(traceIDHeader, traceID)
+			ctx = context.WithValue(ctx, traceIDKey, traceID)
+
+			// Create a fresh logger with request details
+			requestLog := log.With(
+				"traceID", traceID,
+				"ip", r.RemoteAddr,
+				"proto", r.Proto,
+				"method", r.Method,
+				"uri", r.URL.RequestURI(),
+			)
+			ctx = context.WithValue(ctx, requestLoggerKey, requestLog)
+
+			wrapped := &wrappedWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			defer func() {
+				if err := recover(); err != nil {
+					span.SetStatus(codes.Error, fmt.Sprint(err))
+					span.End()
+					panic(err)
+				}
+
+				span.SetName(r.Method + " " + *route)
+				span.SetAttributes(
+					semconv.HTTPRoute(*route),
+					semconv.HTTPResponseStatusCode(wrapped.statusCode),
+				)
+				if wrapped.statusCode >= http.StatusInternalServerError {
+					span.SetStatus(codes.Error, http.StatusText(wrapped.statusCode))
+				}
+				span.End()
+			}()
+
+			next.ServeHTTP(wrapped, r.WithContext(ctx))
+		})
+	}
+}
+
+// legacyTraceParent continues the trace named by an X-Trace-ID header when it
+// holds a valid trace ID, e.g. a UUID sent by an older client. The header
+// carries no sampling decision, so the flags are left unset for the sampler
+// of the tracer provider to decide: the default ParentBased sampler treats it
+// as a remote parent which was not sampled.
+func legacyTraceParent(ctx context.Context, header string) context.Context {
+	traceID, err := trace.TraceIDFromHex(strings.ReplaceAll(header, "-", ""))
+	if err != nil {
+		return ctx
+	}
+
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Remote:  true,
+	})
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+func newTraceID() trace.TraceID {
+	var traceID trace.TraceID
+	_, _ = rand.Read(traceID[:])
+	return traceID
+}
+
+// TracingConfig selects where spans are exported to.
+type TracingConfig struct {
+	ServiceName string
+	// Exporter is one of "otlp", "stdout" or "none". The OTLP exporter is
+	// configured through the standard OTEL_EXPORTER_OTLP_* variables.
+	Exporter string
+	// Output receives the spans of the stdout exporter, os.Stdout when nil.
+	// Tests can point it at a file or buffer.
+	Output io.Writer
+}
+
+// NewTracerProvider builds a tracer provider for the configured exporter and
+// installs it, together with the W3C propagator, as the global default so
+// that libraries such as the pgx tracer pick it up. The provider must be shut
+// down to flush pending spans.
+func NewTracerProvider(ctx context.Context, cfg TracingConfig) (*sdktrace.TracerProvider, error) {
+	var opts []sdktrace.TracerProviderOption
+
+	switch cfg.Exporter {
+	case "otlp":
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case "stdout":
+		stdoutOpts := []stdouttrace.Option{}
+		if cfg.Output != nil {
+			stdoutOpts = append(stdoutOpts, stdouttrace.WithWriter(cfg.Output))
+		}
+		exporter, err := stdouttrace.New(stdoutOpts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case "none", "":
+		// Spans are still created so that trace IDs are propagated.
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter: %s", cfg.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, sdktrace.WithResource(res))
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagator)
+
+	return tp, nil
+}
@@ -0,0 +1,153 @@
+package srvx
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTraceRequest(t *testing.T) {
+	const (
+		upstreamTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		upstreamSpanID  = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name         string
+		headers      map[string]string
+		sampler      sdktrace.Sampler
+		wantTraceID  string
+		wantParentID string
+	}{
+		{
+			name: "new trace",
+		},
+		{
+			name: "traceparent",
+			headers: map[string]string{
+				"traceparent": "00-" + upstreamTraceID + "-" + upstreamSpanID + "-01",
+				"tracestate":  "vendor=value",
+			},
+			wantTraceID:  upstreamTraceID,
+			wantParentID: upstreamSpanID,
+		},
+		{
+			name:    "legacy X-Trace-ID",
+			headers: map[string]string{"X-Trace-ID": "4bf92f35-77b3-4da6-a3ce-929d0e0e4736"},
+			// The header carries no sampling decision, leave it to the
+			// root sampler.
+			sampler:     sdktrace.ParentBased(sdktrace.AlwaysSample(), sdktrace.WithRemoteParentNotSampled(sdktrace.AlwaysSample())),
+			wantTraceID: upstreamTraceID,
+		},
+		{
+			name: "traceparent wins over X-Trace-ID",
+			headers: map[string]string{
+				"traceparent": "00-" + upstreamTraceID + "-" + upstreamSpanID + "-01",
+				"X-Trace-ID":  "11111111-2222-3333-4444-555555555555",
+			},
+			wantTraceID:  upstreamTraceID,
+			wantParentID: upstreamSpanID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			opts := []sdktrace.TracerProviderOption{sdktrace.WithSpanProcessor(recorder)}
+			if tt.sampler != nil {
+				opts = append(opts, sdktrace.WithSampler(tt.sampler))
+			}
+			tp := sdktrace.NewTracerProvider(opts...)
+
+			var ctxTraceID string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /v1/things/{id}", func(_ http.ResponseWriter, r *http.Request) {
+				ctxTraceID = TraceID(r.Context())
+			})
+
+			srv, err := NewServer(Config{TracerProvider: tp}, mux, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/v1/things/1", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			srv.Handler.ServeHTTP(w, r)
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			traceID := span.SpanContext().TraceID().String()
+
+			if span.Name() != "GET /v1/things/{id}" {
+				t.Errorf("expected span name %q, got %q", "GET /v1/things/{id}", span.Name())
+			}
+			if tt.wantTraceID != "" && traceID != tt.wantTraceID {
+				t.Errorf("expected trace ID %s, got %s", tt.wantTraceID, traceID)
+			}
+			if tt.wantParentID != "" && span.Parent().SpanID().String() != tt.wantParentID {
+				t.Errorf("expected parent span %s, got %s", tt.wantParentID, span.Parent().SpanID())
+			}
+			if got := w.Header().Get("X-Trace-ID"); got != traceID {
+				t.Errorf("expected X-Trace-ID %s, got %s", traceID, got)
+			}
+			if ctxTraceID != traceID {
+				t.Errorf("expected trace ID %s in the request context, got %s", traceID, ctxTraceID)
+			}
+			if got, want := w.Header().Get("traceparent"), "00-"+traceID+"-"+span.SpanContext().SpanID().String()+"-01"; got != want {
+				t.Errorf("expected traceparent %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestTraceRequestLegacyTraceIDNotSampled(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(recorder),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.NeverSample())),
+	)
+
+	srv, err := NewServer(Config{TracerProvider: tp}, http.NotFoundHandler(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Trace-ID", "4bf92f35-77b3-4da6-a3ce-929d0e0e4736")
+	w := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(w, r)
+
+	// The legacy header must not force the request to be sampled.
+	if spans := recorder.Ended(); len(spans) != 0 {
+		t.Errorf("expected no sampled span, got %d", len(spans))
+	}
+	if got := w.Header().Get("X-Trace-ID"); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the trace to be continued, got X-Trace-ID %q", got)
+	}
+}
+
+func TestTraceRequestWithoutTracing(t *testing.T) {
+	srv, err := NewServer(Config{TracerProvider: noop.NewTracerProvider()}, http.NotFoundHandler(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if got := w.Header().Get("X-Trace-ID"); len(got) != 32 {
+		t.Errorf("expected a generated X-Trace-ID, got %q", got)
+	}
+}
@@ -9,6 +9,12 @@ type Valid interface {
 	OK() error
 }
 
+// EmailRX is a regular expression for sanity checking the format of email
+// addresses, as recommended by https://html.spec.whatwg.org/#valid-e-mail-address.
+var EmailRX = regexp.MustCompile(
+	"^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$",
+)
+
 // Define a new Validator type which contains a map of validation errors
 type Validator struct {
 	errors map[string]string